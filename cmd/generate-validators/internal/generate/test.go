@@ -4,8 +4,13 @@ package generate
 import (
 	"bytes"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
 	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"text/template"
 
 	"golang.org/x/text/cases"
@@ -20,9 +25,19 @@ type TestInfo struct {
 	TitleCaseName string // e.g., "Required", "Maxlength"
 }
 
-// generateGovalidTests generates individual test files for each validator.
+// generateGovalidTests generates individual test files for each validator, except the validators
+// whose golden tests are run by a hand-written test of their feature.
 func generateGovalidTests(validators []ValidatorInfo, testDir, testTemplate string) error {
+	grouped, err := groupedTests(testDir)
+	if err != nil {
+		return err
+	}
+
 	for _, validator := range validators {
+		if grouped[validator.MarkerName] {
+			continue
+		}
+
 		// Convert to TestInfo
 		testInfo := TestInfo{
 			Name:          validator.MarkerName,
@@ -47,3 +62,40 @@ func generateGovalidTests(validators []ValidatorInfo, testDir, testTemplate stri
 
 	return nil
 }
+
+// groupedTests returns the string literals of the hand-written test files of testDir, which name the
+// testdata of the golden tests they run, such as the cross-field comparisons of fieldcompare_test.go.
+func groupedTests(testDir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(testDir, "*_test.go"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to glob test directory: %w", err)
+	}
+
+	grouped := map[string]bool{}
+
+	for _, file := range files {
+		node, err := parser.ParseFile(token.NewFileSet(), file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse test file %s: %w", file, err)
+		}
+
+		if ast.IsGenerated(node) {
+			continue
+		}
+
+		ast.Inspect(node, func(n ast.Node) bool {
+			lit, ok := n.(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+
+			if value, err := strconv.Unquote(lit.Value); err == nil && !strings.ContainsAny(value, " /") {
+				grouped[value] = true
+			}
+
+			return true
+		})
+	}
+
+	return grouped, nil
+}
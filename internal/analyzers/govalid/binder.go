@@ -0,0 +1,363 @@
+package govalid
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+// bindTags are the struct tags naming the url.Values key of a field, in order of precedence.
+// A struct with at least one of them gets a generated Bind{{Type}}FromValues function.
+var bindTags = []string{"query", "form", "path"}
+
+// BinderData holds the data for generating the url.Values binder of a struct.
+type BinderData struct {
+	// Fields are the bound fields in declaration order.
+	Fields []*BinderField
+	// Files are the *multipart.FileHeader and []*multipart.FileHeader fields bound from multipart forms.
+	Files []*BinderFile
+	// PathParams are the keys of the fields bound from path parameters.
+	PathParams []string
+	// Imports are the packages required by the conversions.
+	Imports []string
+}
+
+// BinderField holds the generated code binding a single field.
+type BinderField struct {
+	// Code is the statement assigning the field from `values`, appending conversion failures to `errs`.
+	Code string
+	// Err is the declaration of the conversion error variable, empty for string fields.
+	Err string
+}
+
+// BinderFile holds a field bound from the files of a multipart form.
+type BinderFile struct {
+	// Key is the name of the form field.
+	Key string
+	// Field is the name of the struct field.
+	Field string
+	// Multiple reports whether the field is []*multipart.FileHeader, taking every file of the key.
+	Multiple bool
+}
+
+// bindConversion describes how to convert a raw string into a value of the target type.
+type bindConversion struct {
+	// parse is the statement parsing `s` into `v` and `err`, empty when no parsing is needed.
+	parse string
+	// value is the expression converting the parsed `v` (or `s`) into the target type.
+	value string
+	// typeName is the name of the target type used in error messages.
+	typeName string
+	// imports are the packages required by parse and value.
+	imports []string
+}
+
+// analyzeBinder returns the binder for a struct whose fields carry query, form or path tags,
+// or nil if no field does. Tagged fields with a type that cannot be converted from a string
+// are reported as diagnostics.
+func analyzeBinder(pass *codegen.Pass, structName string, structType *ast.StructType) (*BinderData, []error) {
+	var (
+		binder *BinderData
+		diags  []error
+	)
+
+	imports := map[string]struct{}{}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 || field.Tag == nil {
+			continue
+		}
+
+		key, tag, ok := bindKey(field)
+		if !ok {
+			continue
+		}
+
+		if binder == nil {
+			binder = &BinderData{}
+		}
+
+		fieldName := field.Names[0].Name
+		typ := pass.TypesInfo.TypeOf(field.Type)
+
+		if multiple, ok := validatorhelper.FileHeader(typ); ok {
+			binder.Files = append(binder.Files, &BinderFile{Key: key, Field: fieldName, Multiple: multiple})
+
+			continue
+		}
+
+		bound, err := bindField(pass, structName, fieldName, key, typ)
+		if err != nil {
+			diags = append(diags, diagnosticf(pass, field.Pos(), "field %s: %v", fieldName, err))
+
+			continue
+		}
+
+		binder.Fields = append(binder.Fields, bound.field)
+		for _, pkg := range bound.imports {
+			imports[pkg] = struct{}{}
+		}
+
+		if tag == "path" {
+			binder.PathParams = append(binder.PathParams, key)
+		}
+	}
+
+	if binder != nil {
+		for pkg := range imports {
+			binder.Imports = append(binder.Imports, pkg)
+		}
+	}
+
+	return binder, diags
+}
+
+// bindKey returns the url.Values key of a field and the tag it was taken from.
+// It reports false for untagged fields and fields tagged with "-".
+func bindKey(field *ast.Field) (string, string, bool) {
+	tags := reflect.StructTag(strings.Trim(field.Tag.Value, "`"))
+
+	for _, tag := range bindTags {
+		value, ok := tags.Lookup(tag)
+		if !ok {
+			continue
+		}
+
+		name, _, _ := strings.Cut(value, ",")
+
+		switch name {
+		case "-":
+			return "", "", false
+		case "":
+			return field.Names[0].Name, tag, true
+		default:
+			return name, tag, true
+		}
+	}
+
+	return "", "", false
+}
+
+// boundField is the result of bindField.
+type boundField struct {
+	field   *BinderField
+	imports []string
+}
+
+// bindField generates the code binding a single field from values[key].
+// Scalars take the first value, slices take every value and pointers are allocated when the key is present.
+// Empty values are ignored for non-string types, as HTML forms submit empty inputs.
+func bindField(pass *codegen.Pass, structName, fieldName, key string, typ types.Type) (*boundField, error) {
+	path := validator.NewFieldPath(structName, fieldName)
+	errVar := fmt.Sprintf("Err%sConversionValidation", path.CleanedPath())
+	target := "t." + fieldName
+	quotedKey := strconv.Quote(key)
+
+	var code strings.Builder
+
+	switch t := typ.Underlying().(type) {
+	case *types.Slice:
+		conv, err := conversionFor(pass, t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		// The slice type may be named in another package than its elements, e.g., ids.List of []string
+		conv.imports = append(conv.imports, typeImports(pass, typ)...)
+
+		fmt.Fprintf(&code, "if raw, ok := values[%s]; ok {\n", quotedKey)
+		fmt.Fprintf(&code, "%s = make(%s, 0, len(raw))\n", target, typeString(pass, typ))
+		code.WriteString("for _, s := range raw {\n")
+		writeConversion(&code, conv, errVar, "continue", func(value string) string {
+			return fmt.Sprintf("%s = append(%s, %s)", target, target, value)
+		})
+		code.WriteString("}\n}\n")
+
+		return newBoundField(code.String(), conv, errVar, fieldName, path), nil
+	case *types.Pointer:
+		conv, err := conversionFor(pass, t.Elem())
+		if err != nil {
+			return nil, err
+		}
+
+		writeScalarBinding(&code, conv, quotedKey, errVar, func(value string) string {
+			return fmt.Sprintf("value := %s\n%s = &value", value, target)
+		})
+
+		return newBoundField(code.String(), conv, errVar, fieldName, path), nil
+	default:
+		conv, err := conversionFor(pass, typ)
+		if err != nil {
+			return nil, err
+		}
+
+		writeScalarBinding(&code, conv, quotedKey, errVar, func(value string) string {
+			return fmt.Sprintf("%s = %s", target, value)
+		})
+
+		return newBoundField(code.String(), conv, errVar, fieldName, path), nil
+	}
+}
+
+// newBoundField assembles a boundField, declaring the conversion error variable when the conversion can fail.
+func newBoundField(code string, conv *bindConversion, errVar, fieldName string, path validator.FieldPath) *boundField {
+	field := &BinderField{Code: code}
+
+	if conv.parse != "" {
+		const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the value of the field cannot be converted to [@TYPENAME].
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be a valid [@TYPENAME]", Path: "[@PATH]", Type: "conversion", Param: "[@TYPENAME]"}
+	`
+
+		field.Err = strings.NewReplacer(
+			"[@ERRVARIABLE]", errVar,
+			"[@FIELD]", fieldName,
+			"[@PATH]", path.String(),
+			"[@TYPENAME]", conv.typeName,
+		).Replace(errTemplate)
+	}
+
+	return &boundField{field: field, imports: conv.imports}
+}
+
+// writeScalarBinding writes the binding of the first value of values[key].
+func writeScalarBinding(code *strings.Builder, conv *bindConversion, quotedKey, errVar string, assign func(string) string) {
+	fmt.Fprintf(code, "if raw, ok := values[%s]; ok && len(raw) > 0 {\n", quotedKey)
+	code.WriteString("s := raw[0]\n")
+	writeConversion(code, conv, errVar, "", assign)
+	code.WriteString("}\n")
+}
+
+// writeConversion writes the conversion of `s`, appending errVar to errs on failure and running onError afterwards.
+func writeConversion(code *strings.Builder, conv *bindConversion, errVar, onError string, assign func(string) string) {
+	if conv.parse == "" {
+		code.WriteString(assign(conv.value) + "\n")
+
+		return
+	}
+
+	code.WriteString("if s != \"\" {\n")
+	code.WriteString(conv.parse + "\n")
+	code.WriteString("if err != nil {\n")
+	fmt.Fprintf(code, "verr := %s\nverr.Value = s\nerrs = append(errs, verr)\n", errVar)
+
+	if onError != "" {
+		code.WriteString(onError + "\n")
+		code.WriteString("}\n")
+		code.WriteString(assign(conv.value) + "\n")
+	} else {
+		code.WriteString("} else {\n")
+		code.WriteString(assign(conv.value) + "\n")
+		code.WriteString("}\n")
+	}
+
+	code.WriteString("}\n")
+}
+
+// conversionFor returns the conversion of a raw string into typ.
+func conversionFor(pass *codegen.Pass, typ types.Type) (*bindConversion, error) {
+	name := typeString(pass, typ)
+	imports := typeImports(pass, typ)
+
+	if name == "time.Duration" {
+		return &bindConversion{
+			parse:    "v, err := time.ParseDuration(s)",
+			value:    "v",
+			typeName: "duration",
+			imports:  []string{"time"},
+		}, nil
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return nil, fmt.Errorf("type %s cannot be bound from url.Values", name)
+	}
+
+	convert := func(v string) string {
+		if name == basic.Name() {
+			return v
+		}
+
+		return fmt.Sprintf("%s(%s)", name, v)
+	}
+
+	conv := &bindConversion{typeName: basic.Name(), imports: imports}
+
+	switch basic.Kind() {
+	case types.String:
+		conv.value = convert("s")
+
+		return conv, nil
+	case types.Bool:
+		conv.parse = "v, err := strconv.ParseBool(s)"
+	case types.Int, types.Int8, types.Int16, types.Int32, types.Int64:
+		conv.parse = fmt.Sprintf("v, err := strconv.ParseInt(s, 10, %d)", bitSize(basic))
+	case types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64:
+		conv.parse = fmt.Sprintf("v, err := strconv.ParseUint(s, 10, %d)", bitSize(basic))
+	case types.Float32, types.Float64:
+		conv.parse = fmt.Sprintf("v, err := strconv.ParseFloat(s, %d)", bitSize(basic))
+	default:
+		return nil, fmt.Errorf("type %s cannot be bound from url.Values", name)
+	}
+
+	if (basic.Kind() == types.Int64 || basic.Kind() == types.Uint64 || basic.Kind() == types.Float64 || basic.Kind() == types.Bool) && name == basic.Name() {
+		conv.value = "v"
+	} else {
+		conv.value = fmt.Sprintf("%s(v)", name)
+	}
+
+	conv.imports = append(conv.imports, "strconv")
+
+	return conv, nil
+}
+
+// bitSize returns the bit size argument of the strconv parse functions for a basic type,
+// 0 standing for the platform size of int and uint.
+func bitSize(basic *types.Basic) int {
+	switch basic.Kind() {
+	case types.Int8, types.Uint8:
+		return 8
+	case types.Int16, types.Uint16:
+		return 16
+	case types.Int32, types.Uint32, types.Float32:
+		return 32
+	case types.Int64, types.Uint64, types.Float64:
+		return 64
+	default:
+		return 0
+	}
+}
+
+// typeString returns the name of typ as written in the generated file of the analyzed package.
+func typeString(pass *codegen.Pass, typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		if pkg == pass.Pkg {
+			return ""
+		}
+
+		return pkg.Name()
+	})
+}
+
+// typeImports returns the packages typeString qualifies the name of typ with.
+func typeImports(pass *codegen.Pass, typ types.Type) []string {
+	var imports []string
+
+	types.TypeString(typ, func(pkg *types.Package) string {
+		if pkg != pass.Pkg {
+			imports = append(imports, pkg.Path())
+		}
+
+		return ""
+	})
+
+	return imports
+}
@@ -0,0 +1,29 @@
+package govalid
+
+import (
+	"fmt"
+	"go/token"
+
+	"github.com/gostaticanalysis/codegen"
+)
+
+// diagnosticError is a problem found in the analyzed source, such as a rule that cannot apply
+// to its field. Once the package has been processed, the generator fails with every diagnostic
+// found, so invalid rules are caught at generation time rather than at runtime.
+type diagnosticError struct {
+	Pos     token.Position
+	Message string
+}
+
+// Error implements the error interface in the `file:line:col: message` form used by go vet.
+func (d *diagnosticError) Error() string {
+	return fmt.Sprintf("%s: %s", d.Pos, d.Message)
+}
+
+// diagnosticf returns a diagnosticError positioned at pos.
+func diagnosticf(pass *codegen.Pass, pos token.Pos, format string, args ...any) error {
+	return &diagnosticError{
+		Pos:     pass.Fset.Position(pos),
+		Message: fmt.Sprintf(format, args...),
+	}
+}
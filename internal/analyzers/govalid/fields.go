@@ -0,0 +1,134 @@
+package govalid
+
+import (
+	"go/types"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/templatedop/govalid/internal/validator"
+)
+
+// fieldsMarker makes the generator emit Validate{{Type}}Fields, validating only the fields of a mask.
+const fieldsMarker = "govalid:fields"
+
+// maskPaths returns the quoted paths, relative to the validated struct, for which the rule of v runs
+// in Validate{{Type}}Fields: the path of its field and, for rules depending on other fields, the paths
+// of those fields. Each path is listed with the Go field names and, when they differ, the JSON keys.
+// It returns an empty string for struct-level rules without known dependencies, which always run.
+func maskPaths(root types.Type, v validator.Validator) string {
+	path := strings.ReplaceAll(v.FieldPath().String(), "[i]", "")
+
+	segments := strings.Split(path, ".")[1:] // drop the struct name
+
+	// Struct-level rules have no field of their own and only run for the fields they depend on
+	var (
+		parent  []string
+		targets [][]string
+	)
+
+	if len(segments) > 0 {
+		parent = segments[:len(segments)-1]
+		targets = append(targets, segments)
+	}
+	if dv, ok := validator.Unwrap(v).(validator.DependentValidator); ok {
+		for _, field := range dv.DependsOn() {
+			targets = append(targets, dependencyPath(parent, field))
+		}
+	}
+
+	var (
+		paths []string
+		seen  = map[string]bool{}
+	)
+
+	for _, target := range targets {
+		for _, p := range []string{strings.Join(target, "."), jsonPath(root, target)} {
+			if p == "" || seen[p] {
+				continue
+			}
+
+			seen[p] = true
+			paths = append(paths, strconv.Quote(p))
+		}
+	}
+
+	return strings.Join(paths, ", ")
+}
+
+// dependencyPath returns the segments of the path of a field a rule depends on, given as
+// validator.DependentValidator documents it, from the validated struct.
+func dependencyPath(parent []string, field string) []string {
+	base := parent
+
+	switch {
+	case strings.HasPrefix(field, validator.RootRefPrefix):
+		base, field = nil, strings.TrimPrefix(field, validator.RootRefPrefix)
+	case strings.HasPrefix(field, ".."):
+		rel := strings.TrimLeft(field, ".")
+		base = parent[:max(len(parent)-(len(field)-len(rel)-1), 0)]
+		field = rel
+	}
+
+	return append(append([]string{}, base...), strings.Split(field, ".")...)
+}
+
+// jsonPath returns the path of the Go field segments under root using the JSON keys of the fields,
+// or an empty string if a segment cannot be resolved.
+func jsonPath(root types.Type, segments []string) string {
+	keys := make([]string, 0, len(segments))
+	typ := root
+
+	for _, segment := range segments {
+		st, ok := structOf(typ)
+		if !ok {
+			return ""
+		}
+
+		found := false
+
+		for i := range st.NumFields() {
+			field := st.Field(i)
+			if field.Name() != segment {
+				continue
+			}
+
+			key, _, _ := strings.Cut(reflect.StructTag(st.Tag(i)).Get("json"), ",")
+			if key == "" || key == "-" {
+				key = field.Name()
+			}
+
+			keys = append(keys, key)
+			typ = field.Type()
+			found = true
+
+			break
+		}
+
+		if !found {
+			return ""
+		}
+	}
+
+	return strings.Join(keys, ".")
+}
+
+// structOf returns the struct type of typ, looking through pointers and the elements of collections.
+func structOf(typ types.Type) (*types.Struct, bool) {
+	for {
+		switch t := typ.Underlying().(type) {
+		case *types.Struct:
+			return t, true
+		case *types.Pointer:
+			typ = t.Elem()
+		case *types.Slice:
+			typ = t.Elem()
+		case *types.Array:
+			typ = t.Elem()
+		case *types.Map:
+			typ = t.Elem()
+		default:
+			return nil, false
+		}
+	}
+}
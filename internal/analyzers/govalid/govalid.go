@@ -2,12 +2,14 @@ package govalid
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
 	"go/format"
 	"go/token"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
 	"strings"
 	"testing"
@@ -56,9 +58,17 @@ func newGenerator() (*codegen.Generator, error) {
 
 // TemplateData holds the data for the template used to generate validation code.
 type TemplateData struct {
-	PackageName    string
-	TypeName       string
-	Metadata       []*AnalyzedMetadata
+	PackageName string
+	TypeName    string
+	Metadata    []*AnalyzedMetadata
+	Binder      *BinderData
+	Presence    *PresenceData
+	Unmarshal   bool
+	Fields      bool
+	Update      bool
+	Groups      bool
+	// Value is set for named non-struct types, such as type Email string, validated by value.
+	Value          bool
 	ImportPackages map[string]struct{}
 }
 
@@ -98,6 +108,12 @@ func (g *generator) run(pass *codegen.Pass) error {
 
 	tmplList := map[string]TemplateData{}
 
+	var diags []error
+
+	report := func(pos token.Pos, format string, args ...any) {
+		diags = append(diags, diagnosticf(pass, pos, format, args...))
+	}
+
 	inspector.Preorder(nodeFilter, func(n ast.Node) {
 		genDecl, ok := n.(*ast.GenDecl)
 		if !ok || genDecl.Tok != token.TYPE {
@@ -107,29 +123,101 @@ func (g *generator) run(pass *codegen.Pass) error {
 		for _, spec := range genDecl.Specs {
 			ts, ok := spec.(*ast.TypeSpec)
 			if !ok {
-				return
+				continue
 			}
 
 			typeMarkers := markersInspect.TypeMarkers(ts)
 
 			structType, ok := ts.Type.(*ast.StructType)
 			if !ok {
-				return
+				metadata := analyzeNamedType(pass, markersInspect, ts, report)
+				if len(metadata) == 0 {
+					continue
+				}
+
+				tmplData := TemplateData{
+					PackageName:    pass.Pkg.Name(),
+					TypeName:       ts.Name.Name,
+					Metadata:       metadata,
+					Value:          true,
+					ImportPackages: collectImportPackages(metadata),
+				}
+
+				if err := writeFile(pass, ts, tmplData); err != nil {
+					panic(fmt.Sprintf("failed to write file for %s: %v", ts.Name.Name, err))
+				}
+
+				continue
 			}
 
-			metadata := analyzeMarker(pass, markersInspect, typeMarkers, structType, "", ts.Name.Name, typeMap)
-			if len(metadata) == 0 {
-				return
+			presence, presenceDiags := analyzePresence(pass, markersInspect, typeMarkers, ts, structType)
+			diags = append(diags, presenceDiags...)
+
+			var presenceField string
+			if presence != nil {
+				presenceField = presence.Field
+			}
+
+			metadata := analyzeMarker(pass, markersInspect, typeMarkers, structType, "", ts.Name.Name, typeMap, presenceField, report)
+
+			binder, binderDiags := analyzeBinder(pass, ts.Name.Name, structType)
+			diags = append(diags, binderDiags...)
+
+			unmarshal := hasMarker(typeMarkers, unmarshalMarker)
+			fields := hasMarker(typeMarkers, fieldsMarker)
+
+			structRules := analyzeStructRules(pass, typeMarkers, ts, report)
+
+			if len(metadata) == 0 && len(structRules) == 0 && binder == nil && presence == nil && !unmarshal {
+				continue
 			}
 
 			// Consolidate validators with the same ParentVariable into single loops for performance
 			metadata = consolidateMetadata(metadata)
 
+			// Struct-level rules run once all the fields are validated
+			if len(structRules) > 0 {
+				metadata = append(metadata, &AnalyzedMetadata{Validators: structRules})
+			}
+
+			importPackages := collectImportPackages(metadata)
+			if binder != nil {
+				importPackages["net/url"] = struct{}{}
+				if len(binder.Files) > 0 {
+					importPackages["mime/multipart"] = struct{}{}
+				}
+				for _, pkg := range binder.Imports {
+					importPackages[pkg] = struct{}{}
+				}
+			}
+
+			if presence != nil || unmarshal {
+				importPackages["encoding/json"] = struct{}{}
+			}
+
+			if presence != nil {
+				importPackages["strings"] = struct{}{}
+			}
+
+			if fields {
+				importPackages["github.com/templatedop/govalid/validation/fieldmask"] = struct{}{}
+			}
+
+			if hasGroups(metadata) {
+				importPackages["github.com/templatedop/govalid/validation/validationhelper"] = struct{}{}
+			}
+
 			tmplData := TemplateData{
 				PackageName:    pass.Pkg.Name(),
 				TypeName:       ts.Name.Name,
 				Metadata:       metadata,
-				ImportPackages: collectImportPackages(metadata),
+				Binder:         binder,
+				Presence:       presence,
+				Unmarshal:      unmarshal,
+				Fields:         fields,
+				Update:         hasTransitions(metadata),
+				Groups:         hasGroups(metadata),
+				ImportPackages: importPackages,
 			}
 
 			data, ok := tmplList[ts.Name.Name]
@@ -143,7 +231,7 @@ func (g *generator) run(pass *codegen.Pass) error {
 		}
 	})
 
-	return nil
+	return errors.Join(diags...)
 }
 
 // AnalyzedMetadata holds the metadata for a field in a struct, including its validators and parent variable name.
@@ -208,15 +296,27 @@ type makeValidatorInput struct {
 	Field      *ast.Field
 	StructName string
 	ParentPath string
+	Presence   string
+	Report     registry.ReportFunc
+	// Pointee is the field holding the value a pointer field points to, against which the
+	// PointeeMarkers inherited from the type of that value are checked, see pointeeField.
+	Pointee        *ast.Field
+	PointeeMarkers []markers.Marker
 }
 
+// analyzeMarker collects the validators of the fields of structType. presence is the name of the
+// govalid.Presence field of a +govalid:presence struct; it only applies to the direct fields of the
+// struct, so nested structs are analyzed without it. The rules report their diagnostics to report.
+//
 //nolint:funlen // This function is complex but cohesive - it handles complete field analysis including nested structs
-func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarkers markers.MarkerSet, structType *ast.StructType, parent, structName string, typeMap map[string]*ast.StructType) []*AnalyzedMetadata {
+func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarkers markers.MarkerSet, structType *ast.StructType, parent, structName string, typeMap map[string]*ast.StructType, presence string, report registry.ReportFunc) []*AnalyzedMetadata {
 	analyzed := make([]*AnalyzedMetadata, 0)
 
 	typeMarkersList := make([]markers.Marker, 0, len(typeMarkers))
 	for _, marker := range typeMarkers {
-		typeMarkersList = append(typeMarkersList, marker)
+		if appliesToFields(marker) {
+			typeMarkersList = append(typeMarkersList, marker)
+		}
 	}
 
 	sort.SliceStable(typeMarkersList, func(i, j int) bool {
@@ -238,8 +338,19 @@ func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarke
 			return fieldMarkersList[i].Identifier < fieldMarkersList[j].Identifier
 		})
 
-		markersList := make([]markers.Marker, 0, len(typeMarkersList)+len(fieldMarkersList))
+		namedMarkersList := namedTypeMarkers(pass, markersInspect, field, fieldMarkers)
+
+		// The markers inherited by a pointer field apply to the value it points to
+		var pointeeMarkersList []markers.Marker
+
+		pointee := pointeeField(field)
+		if pointee != nil {
+			pointeeMarkersList, namedMarkersList = namedMarkersList, nil
+		}
+
+		markersList := make([]markers.Marker, 0, len(typeMarkersList)+len(namedMarkersList)+len(fieldMarkersList))
 		markersList = append(markersList, typeMarkersList...)
+		markersList = append(markersList, namedMarkersList...)
 		markersList = append(markersList, fieldMarkersList...)
 
 		input := makeValidatorInput{
@@ -248,6 +359,11 @@ func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarke
 			Field:      field,
 			StructName: structName,
 			ParentPath: parent,
+			Presence:   presence,
+			Report:     report,
+
+			Pointee:        pointee,
+			PointeeMarkers: pointeeMarkersList,
 		}
 
 		// Check for dive marker on collection types to validate nested elements.
@@ -296,7 +412,7 @@ func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarke
 			}
 
 			// Recursively analyze nested inline structs
-			analyzed = append(analyzed, analyzeMarker(pass, markersInspect, typeMarkers, st, parentVariable, structName, typeMap)...)
+			analyzed = append(analyzed, analyzeMarker(pass, markersInspect, typeMarkers, st, parentVariable, structName, typeMap, "", report)...)
 			continue
 		}
 
@@ -333,7 +449,7 @@ func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarke
 					}
 					idxParent := fmt.Sprintf("%s[i]", base)
 					// Analyze element struct using the parent type name to keep full path
-					analyzed = append(analyzed, analyzeMarker(pass, markersInspect, nil, elStruct, idxParent, structName, typeMap)...)
+					analyzed = append(analyzed, analyzeMarker(pass, markersInspect, nil, elStruct, idxParent, structName, typeMap, "", report)...)
 				}
 				// Done handling collection dive.
 				continue
@@ -349,7 +465,7 @@ func analyzeMarker(pass *codegen.Pass, markersInspect markers.Markers, typeMarke
 					base = field.Names[0].Name
 				}
 				// Analyze nested struct
-				analyzed = append(analyzed, analyzeMarker(pass, markersInspect, nil, target, base, structName, typeMap)...)
+				analyzed = append(analyzed, analyzeMarker(pass, markersInspect, nil, target, base, structName, typeMap, "", report)...)
 				continue
 			}
 
@@ -402,7 +518,23 @@ func resolveStructTypeFromExpr(expr ast.Expr, typeMap map[string]*ast.StructType
 func makeValidator(input makeValidatorInput) []validator.Validator {
 	validators := make([]validator.Validator, 0)
 
+	nullable := false
 	for _, marker := range input.Markers {
+		if marker.Identifier == nullableMarker {
+			nullable = true
+		}
+	}
+
+	var (
+		guards []validator.Validator
+		rules  []validator.Validator
+		groups [][]string
+	)
+
+	allMarkers := slices.Concat(input.Markers, input.PointeeMarkers)
+	occurrences := map[string]int{}
+
+	for i, marker := range allMarkers {
 		factory, err := registry.Validator(marker.Identifier)
 		if err != nil {
 			// Validator not found, skip
@@ -411,20 +543,61 @@ func makeValidator(input makeValidatorInput) []validator.Validator {
 
 		ruleName := strings.TrimPrefix(marker.Identifier, "govalid:")
 
+		field := input.Field
+		if i >= len(input.Markers) {
+			field = input.Pointee
+		}
+
 		validatorInput := registry.ValidatorInput{
 			Pass:        input.Pass,
-			Field:       input.Field,
+			Field:       field,
 			Expressions: marker.Expressions,
 			StructName:  input.StructName,
 			RuleName:    ruleName,
 			ParentPath:  input.ParentPath,
+			Presence:    input.Presence,
+			Nullable:    nullable,
+			DeclaredBy:  marker.DeclaredBy(),
+			Occurrence:  occurrences[marker.Identifier],
+			Report:      input.Report,
 		}
+		occurrences[marker.Identifier]++
+
 		v := factory(validatorInput)
 
 		if v == nil {
 			continue
 		}
 
+		if validator.IsGuard(v) {
+			if v.Validate() == "" {
+				return nil
+			}
+
+			guards = append(guards, v)
+
+			continue
+		}
+
+		if field != input.Field {
+			v = &validator.DerefValidator{Validator: v}
+		}
+
+		rules = append(rules, v)
+		groups = append(groups, marker.Groups)
+	}
+
+	for i, v := range rules {
+		// The guards of the field, such as +govalid:if, apply to all its rules
+		if len(guards) > 0 {
+			v = &validator.GuardedValidator{Validator: v, Guards: guards}
+		}
+
+		// Transition rules only run in Validate{{Type}}Update, which ignores the groups
+		if len(groups[i]) > 0 && !validator.IsTransition(v) {
+			v = &validator.GroupedValidator{Validator: v, Groups: groups[i]}
+		}
+
 		validators = append(validators, v)
 	}
 
@@ -458,6 +631,9 @@ func writeFile(pass *codegen.Pass, ts *ast.TypeSpec, tmplData TemplateData) erro
 			// convert something like Parent.Field[i] to Parent.Field
 			return strings.TrimSuffix(s, "[i]")
 		},
+		"rules": func(metadata []*AnalyzedMetadata, mode string) rulesData {
+			return newRulesData(pass.TypesInfo.Defs[ts.Name].Type(), metadata, mode)
+		},
 	}).Parse(ValidationTemplate)
 	if err != nil {
 		return fmt.Errorf("failed to parse template: %w", err)
@@ -0,0 +1,102 @@
+package govalid
+
+import (
+	"go/ast"
+	"go/types"
+	"slices"
+	"sort"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/analyzers/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// namedTypeMarkers returns the markers declared on the named non-struct type of field, e.g.,
+// +govalid:email on type Email string, which apply to every field of that type or of a pointer
+// to it, see pointeeField. The markers declared on the field take precedence over those of its type.
+func namedTypeMarkers(pass *codegen.Pass, markersInspect markers.Markers, field *ast.Field, fieldMarkers markers.MarkerSet) []markers.Marker {
+	typeExpr := field.Type
+	if star, ok := typeExpr.(*ast.StarExpr); ok {
+		typeExpr = star.X
+	}
+
+	named, ok := types.Unalias(pass.TypesInfo.TypeOf(typeExpr)).(*types.Named)
+	if !ok {
+		return nil
+	}
+
+	inherited := make([]markers.Marker, 0)
+
+	for _, marker := range markersInspect.NamedTypeMarkers(named.Obj()) {
+		overridden := slices.ContainsFunc(fieldMarkers, func(m markers.Marker) bool {
+			return m.Identifier == marker.Identifier
+		})
+
+		if !overridden {
+			inherited = append(inherited, marker)
+		}
+	}
+
+	sort.SliceStable(inherited, func(i, j int) bool {
+		return inherited[i].Identifier < inherited[j].Identifier
+	})
+
+	return inherited
+}
+
+// pointeeField returns the field holding the value the pointer field points to, e.g., Email for
+// a *Email field, or nil if field is not a pointer. The markers the field inherits from the type of
+// that value are checked against it, and only when the pointer is not nil.
+func pointeeField(field *ast.Field) *ast.Field {
+	star, ok := field.Type.(*ast.StarExpr)
+	if !ok {
+		return nil
+	}
+
+	return &ast.Field{Doc: field.Doc, Names: field.Names, Type: star.X, Tag: field.Tag, Comment: field.Comment}
+}
+
+// analyzeNamedType collects the validators of the markers declared on the named non-struct type ts,
+// generating Validate{{Type}} for a value of that type. The value is validated as the field of
+// a struct named after the type, so the rules are the same as for the fields of that type.
+func analyzeNamedType(pass *codegen.Pass, markersInspect markers.Markers, ts *ast.TypeSpec, report registry.ReportFunc) []*AnalyzedMetadata {
+	// Methods cannot be declared on aliases, and the rules need an instantiated type
+	if ts.Assign.IsValid() || ts.TypeParams != nil {
+		return nil
+	}
+
+	tn, ok := pass.TypesInfo.Defs[ts.Name].(*types.TypeName)
+	if !ok {
+		return nil
+	}
+
+	typeMarkers := markersInspect.NamedTypeMarkers(tn)
+	if len(typeMarkers) == 0 {
+		return nil
+	}
+
+	markersList := slices.Clone(typeMarkers)
+
+	sort.SliceStable(markersList, func(i, j int) bool {
+		return markersList[i].Identifier < markersList[j].Identifier
+	})
+
+	field := &ast.Field{
+		Names: []*ast.Ident{{Name: ts.Name.Name, NamePos: ts.Name.Pos()}},
+		Type:  ts.Name,
+	}
+
+	validators := makeValidator(makeValidatorInput{
+		Pass:    pass,
+		Markers: markersList,
+		Field:   field,
+		Report:  report,
+	})
+
+	if len(validators) == 0 {
+		return nil
+	}
+
+	return []*AnalyzedMetadata{{Validators: validators}}
+}
@@ -0,0 +1,127 @@
+package govalid
+
+import (
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/analyzers/markers"
+)
+
+const (
+	// presenceMarker opts a struct into recording the fields present in decoded JSON payloads.
+	presenceMarker = "govalid:presence"
+	// nullableMarker lets a required field of a presence struct be an explicit null.
+	nullableMarker = "govalid:nullable"
+	// notNullMarker rejects an explicit null for a field of a presence struct.
+	notNullMarker = "govalid:not_null"
+	// unmarshalMarker makes the generated UnmarshalJSON method validate the decoded struct.
+	unmarshalMarker = "govalid:unmarshal"
+
+	// presenceType is the type of the field recording the presence.
+	presenceType = "github.com/templatedop/govalid.Presence"
+)
+
+// PresenceData holds the data for generating the UnmarshalJSON method of a +govalid:presence struct.
+type PresenceData struct {
+	// Field is the name of the govalid.Presence field.
+	Field string
+	// Keys are the JSON keys of the recorded fields in declaration order.
+	Keys []*PresenceKey
+}
+
+// PresenceKey maps a JSON key to the name of the field decoded from it.
+type PresenceKey struct {
+	Key   string
+	Field string
+}
+
+// analyzePresence returns the presence data of a struct marked with +govalid:presence, or nil for
+// other structs. A presence struct without a govalid.Presence field, and nullable or not_null
+// markers on fields of other structs, are reported as diagnostics.
+func analyzePresence(pass *codegen.Pass, markersInspect markers.Markers, typeMarkers markers.MarkerSet, ts *ast.TypeSpec, structType *ast.StructType) (*PresenceData, []error) {
+	if !hasMarker(typeMarkers, presenceMarker) {
+		var diags []error
+
+		for _, field := range structType.Fields.List {
+			fieldMarkers := markersInspect.FieldMarkers(field)
+			for _, identifier := range []string{nullableMarker, notNullMarker} {
+				if hasMarker(fieldMarkers, identifier) {
+					diags = append(diags, diagnosticf(pass, field.Pos(), "%s requires the %s type %s to be marked with +%s",
+						strings.TrimPrefix(identifier, "govalid:"), field.Names[0].Name, ts.Name.Name, presenceMarker))
+				}
+			}
+		}
+
+		return nil, diags
+	}
+
+	data := &PresenceData{}
+
+	for _, field := range structType.Fields.List {
+		if len(field.Names) == 0 {
+			continue
+		}
+
+		if isPresenceType(pass.TypesInfo.TypeOf(field.Type)) {
+			data.Field = field.Names[0].Name
+
+			continue
+		}
+
+		key, ok := jsonKey(field)
+		if !ok {
+			continue
+		}
+
+		data.Keys = append(data.Keys, &PresenceKey{Key: key, Field: field.Names[0].Name})
+	}
+
+	if data.Field == "" {
+		return nil, []error{diagnosticf(pass, ts.Pos(), "%s is marked with +%s but has no govalid.Presence field", ts.Name.Name, presenceMarker)}
+	}
+
+	return data, nil
+}
+
+// jsonKey returns the JSON key of a field as encoding/json names it, reporting false for fields tagged with "-".
+func jsonKey(field *ast.Field) (string, bool) {
+	if field.Tag == nil {
+		return field.Names[0].Name, true
+	}
+
+	name, _, _ := strings.Cut(reflect.StructTag(strings.Trim(field.Tag.Value, "`")).Get("json"), ",")
+
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Names[0].Name, true
+	default:
+		return name, true
+	}
+}
+
+// isPresenceType reports whether typ is govalid.Presence.
+func isPresenceType(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path()+"."+named.Obj().Name() == presenceType
+}
+
+// hasMarker reports whether ms contains a marker with the identifier.
+func hasMarker(ms markers.MarkerSet, identifier string) bool {
+	for _, m := range ms {
+		if m.Identifier == identifier {
+			return true
+		}
+	}
+
+	return false
+}
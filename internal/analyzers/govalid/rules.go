@@ -0,0 +1,212 @@
+package govalid
+
+import (
+	"fmt"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/templatedop/govalid/internal/validator"
+)
+
+// Modes of the "rules" template.
+const (
+	// rulesValidate renders the rules of Validate{{Type}}.
+	rulesValidate = ""
+	// rulesFields renders the rules of Validate{{Type}}Fields, guarded by the field mask.
+	rulesFields = "fields"
+	// rulesUpdate renders the transition rules of Validate{{Type}}Update.
+	rulesUpdate = "update"
+	// rulesGroups renders the rules of Validate{{Type}}Groups, guarding the grouped ones.
+	rulesGroups = "groups"
+)
+
+// rulesData is the data of the "rules" template, which renders the checks of Metadata,
+// guarding each one with the field mask when Masked is set and with the requested groups
+// when Grouped is set, and scoping the previous value old along with t in nested structs
+// when Transition is set.
+type rulesData struct {
+	Metadata   []*AnalyzedMetadata
+	Masked     bool
+	Transition bool
+	Grouped    bool
+
+	// root is the validated struct type, resolving the JSON keys of the mask paths.
+	root types.Type
+}
+
+// newRulesData returns the data of the "rules" template in the given mode. The transition rules
+// only run in Validate{{Type}}Update, which reaches the other rules by calling Validate{{Type}},
+// and the grouped rules only run in Validate{{Type}}Groups.
+func newRulesData(root types.Type, metadata []*AnalyzedMetadata, mode string) rulesData {
+	var keep func(v validator.Validator) bool
+
+	switch mode {
+	case rulesValidate, rulesFields:
+		keep = func(v validator.Validator) bool {
+			return !validator.IsTransition(v) && len(validator.Groups(v)) == 0
+		}
+	case rulesUpdate:
+		keep = validator.IsTransition
+	case rulesGroups:
+		keep = func(v validator.Validator) bool {
+			return !validator.IsTransition(v)
+		}
+	}
+
+	return rulesData{
+		Metadata:   selectRules(metadata, keep),
+		Masked:     mode == rulesFields,
+		Transition: mode == rulesUpdate,
+		Grouped:    mode == rulesGroups,
+		root:       root,
+	}
+}
+
+// UsesRoot reports whether a rule references the validated struct as root, which the
+// "rules" template then declares before shadowing t in nested structs.
+func (d rulesData) UsesRoot() bool {
+	return len(selectRules(d.Metadata, validator.UsesRoot)) > 0
+}
+
+// Condition returns the condition under which the rule of v reports an error.
+func (d rulesData) Condition(v validator.Validator) string {
+	var guards []string
+
+	if d.Masked {
+		if paths := maskPaths(d.root, v); paths != "" {
+			guards = append(guards, fmt.Sprintf("fieldmask.Covers(mask, %s)", paths))
+		}
+	}
+
+	if groups := validator.Groups(v); d.Grouped && len(groups) > 0 {
+		quoted := make([]string, len(groups))
+		for i, group := range groups {
+			quoted[i] = strconv.Quote(group)
+		}
+
+		guards = append(guards, fmt.Sprintf("validationhelper.InGroups(groups, %s)", strings.Join(quoted, ", ")))
+	}
+
+	for _, guard := range validator.Guards(v) {
+		guards = append(guards, guard.Validate())
+	}
+
+	if len(guards) == 0 {
+		return v.Validate()
+	}
+
+	return fmt.Sprintf("%s && (%s)", strings.Join(guards, " && "), v.Validate())
+}
+
+// ParentSet returns the condition reporting whether the nested struct at parentVariable is set in
+// variable, t or old, checking that none of the pointers leading to it is nil, or "" if none of
+// them is a pointer.
+func (d rulesData) ParentSet(parentVariable, variable string) string {
+	var guards []string
+
+	for _, prefix := range pointerPrefixes(d.root, parentVariable) {
+		guards = append(guards, fmt.Sprintf("%s.%s != nil", variable, prefix))
+	}
+
+	return strings.Join(guards, " && ")
+}
+
+// NilParentCondition returns the condition under which the transition rule of v reports an error
+// when the nested struct at parentVariable is nil in t or in old, or "" if it reports none then.
+func (d rulesData) NilParentCondition(v validator.Validator, parentVariable string) string {
+	return validator.NilParentChanged(v,
+		d.ParentSet(parentVariable, "t"), d.ParentSet(parentVariable, "old"), "old."+parentVariable)
+}
+
+// pointerPrefixes returns the prefixes of the path of fields from the struct root, e.g., "Addr" and
+// "Addr.Geo" for "Addr.Geo", whose fields hold pointers. Paths through collections are not followed.
+func pointerPrefixes(root types.Type, path string) []string {
+	if root == nil || path == "" || strings.Contains(path, "[") {
+		return nil
+	}
+
+	var prefixes []string
+
+	typ := root
+	segments := strings.Split(path, ".")
+
+	for i, segment := range segments {
+		st, ok := derefType(typ).Underlying().(*types.Struct)
+		if !ok {
+			return prefixes
+		}
+
+		found := false
+
+		for j := range st.NumFields() {
+			if st.Field(j).Name() != segment {
+				continue
+			}
+
+			typ = st.Field(j).Type()
+			found = true
+
+			break
+		}
+
+		if !found {
+			return prefixes
+		}
+
+		if _, ok := typ.Underlying().(*types.Pointer); ok {
+			prefixes = append(prefixes, strings.Join(segments[:i+1], "."))
+		}
+	}
+
+	return prefixes
+}
+
+// derefType returns the element type of the pointer type typ, or typ.
+func derefType(typ types.Type) types.Type {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		return ptr.Elem()
+	}
+
+	return typ
+}
+
+// selectRules returns the metadata keeping only the validators for which keep reports true,
+// and dropping the entries left without validators.
+func selectRules(metadata []*AnalyzedMetadata, keep func(validator.Validator) bool) []*AnalyzedMetadata {
+	selected := make([]*AnalyzedMetadata, 0, len(metadata))
+
+	for _, meta := range metadata {
+		validators := make([]validator.Validator, 0, len(meta.Validators))
+		for _, v := range meta.Validators {
+			if keep(v) {
+				validators = append(validators, v)
+			}
+		}
+
+		if len(validators) == 0 {
+			continue
+		}
+
+		selected = append(selected, &AnalyzedMetadata{
+			Validators:     validators,
+			ParentVariable: meta.ParentVariable,
+		})
+	}
+
+	return selected
+}
+
+// hasTransitions reports whether the metadata contains transition rules,
+// for which Validate{{Type}}Update is generated.
+func hasTransitions(metadata []*AnalyzedMetadata) bool {
+	return len(selectRules(metadata, validator.IsTransition)) > 0
+}
+
+// hasGroups reports whether the metadata contains grouped rules,
+// for which Validate{{Type}}Groups is generated.
+func hasGroups(metadata []*AnalyzedMetadata) bool {
+	return len(selectRules(metadata, func(v validator.Validator) bool {
+		return len(validator.Groups(v)) > 0
+	})) > 0
+}
@@ -0,0 +1,53 @@
+package govalid
+
+import (
+	"go/ast"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/analyzers/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// appliesToFields reports whether a type marker applies to each field of the struct, which
+// requires the each option.
+func appliesToFields(m markers.Marker) bool {
+	return m.Each
+}
+
+// analyzeStructRules returns the validators of the struct-level rules declared on the type ts.
+// The markers of the other rules only apply to the fields with the each option; without it, they
+// are reported rather than silently applied to each field.
+func analyzeStructRules(pass *codegen.Pass, typeMarkers markers.MarkerSet, ts *ast.TypeSpec, report registry.ReportFunc) []validator.Validator {
+	var structMarkers []markers.Marker
+
+	for _, m := range typeMarkers {
+		if m.Each {
+			continue
+		}
+
+		if markers.IsStructLevel(m) {
+			structMarkers = append(structMarkers, m)
+
+			continue
+		}
+
+		if _, err := registry.Validator(m.Identifier); err == nil {
+			report(ts.Pos(), "%s: the rule has no struct-level meaning, add the each option to apply it to each field of %s, e.g., +%s;each",
+				strings.TrimPrefix(m.Identifier, "govalid:"), ts.Name.Name, m.Identifier)
+		}
+	}
+
+	if len(structMarkers) == 0 {
+		return nil
+	}
+
+	return makeValidator(makeValidatorInput{
+		Pass:       pass,
+		Markers:    structMarkers,
+		StructName: ts.Name.Name,
+		Report:     report,
+	})
+}
@@ -0,0 +1,39 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/gostaticanalysis/codegen/codegentest"
+
+	"github.com/templatedop/govalid/internal/analyzers/govalid"
+	"github.com/templatedop/govalid/internal/analyzers/markers"
+	"github.com/templatedop/govalid/internal/analyzers/registry"
+)
+
+// TestFieldComparison runs the golden tests of the cross-field comparison markers, comparing a field
+// with a sibling field, or with a field at a path from the validated struct for the csfield variants.
+func TestFieldComparison(t *testing.T) {
+	registry := registry.NewRegistry(
+		registry.AddAnalyzers(markers.Initializer()),
+		registry.AddGenerators(govalid.Initializer()),
+	)
+
+	if err := registry.Init(nil); err != nil {
+		t.Fatalf("failed to initialize analyzers: %v", err)
+	}
+
+	govalid, err := registry.Generator(govalid.Name)
+	if err != nil {
+		t.Fatalf("failed to get govalid generator: %v", err)
+	}
+
+	for _, marker := range []string{
+		"eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield",
+		"eqcsfield", "necsfield", "gtcsfield", "gtecsfield", "ltcsfield", "ltecsfield",
+	} {
+		t.Run(marker, func(t *testing.T) {
+			results := codegentest.Run(t, codegentest.TestData(), govalid, marker)
+			codegentest.Golden(t, results, update)
+		})
+	}
+}
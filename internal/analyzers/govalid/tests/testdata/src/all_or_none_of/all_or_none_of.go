@@ -0,0 +1,8 @@
+package all_or_none_of
+
+// +govalid:all_or_none_of=Latitude Longitude Labels
+type Geo struct {
+	Latitude  float64
+	Longitude float64
+	Labels    map[string]string
+}
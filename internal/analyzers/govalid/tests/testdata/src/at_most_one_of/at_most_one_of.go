@@ -0,0 +1,7 @@
+package at_most_one_of
+
+// +govalid:at_most_one_of=Percentage Amount
+type Discount struct {
+	Percentage float64
+	Amount     int
+}
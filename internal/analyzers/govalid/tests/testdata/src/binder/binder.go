@@ -0,0 +1,48 @@
+package binder
+
+import (
+	"binder/ids"
+	"time"
+)
+
+// Sort is a named string type bound by conversion.
+type Sort string
+
+// ListUsers is a struct for testing binders generated from query, form and path tags.
+type ListUsers struct {
+	// +govalid:required
+	Org string `path:"org"`
+
+	// +govalid:maxlength=50
+	Name string `query:"name"`
+
+	// +govalid:lte=100
+	Limit int `query:"limit"`
+
+	Offset uint32 `query:"offset"`
+
+	Score float64 `form:"score"`
+
+	Active bool `query:"active"`
+
+	Timeout time.Duration `query:"timeout"`
+
+	Sort Sort `query:"sort"`
+
+	IDs []int64 `query:"id"`
+
+	Tags []string `query:"tag"`
+
+	Labels ids.List `query:"label"`
+
+	Since *int `query:"since"`
+
+	Untagged string
+
+	Ignored string `query:"-"`
+}
+
+// Search has no validation rules but still gets a binder.
+type Search struct {
+	Query string `query:"q"`
+}
@@ -0,0 +1,4 @@
+package ids
+
+// List is a named slice type declared in another package than the struct binding it.
+type List []string
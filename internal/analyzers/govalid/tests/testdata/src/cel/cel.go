@@ -140,3 +140,65 @@ type CEL struct {
 	// +govalid:cel=size(value.map(item, size(item))) == size(value)
 	MappedSizes []string
 }
+
+type CELStatus string
+
+type CELAddress struct {
+	Country string `json:"country"`
+	Zip     string `json:"zip"`
+}
+
+// CELTyped covers the expressions type-checked against the Go types of the struct.
+type CELTyped struct {
+	// Timestamps are compared with the methods of time.Time
+	// +govalid:cel=value < this.End || this.End == timestamp('2000-01-01T00:00:00Z')
+	Start time.Time `json:"start"`
+
+	// +govalid:cel=value > this.start && value - this.start <= this.max_window
+	End time.Time `json:"end"`
+
+	MaxWindow time.Duration `json:"max_window"`
+
+	// Fields of nested structs and JSON names
+	// +govalid:cel=this.address.country != 'US' || size(this.Address.zip) == 5
+	Address CELAddress `json:"address"`
+
+	// Named strings are converted for the strings functions
+	// +govalid:cel=value.startsWith('st-') && value != this.Previous
+	Status CELStatus `json:"status"`
+
+	Previous string `json:"previous"`
+
+	// Numbers of different Go and CEL types
+	// +govalid:cel=value <= this.Limit && value > 0.5
+	Count int32 `json:"count"`
+
+	Limit int `json:"limit"`
+
+	// Maps lookup their keys
+	// +govalid:cel='owner' in value
+	Labels map[string]string `json:"labels"`
+}
+
+// CELExtended covers the functions of the CEL standard library and of the extensions of cel-go.
+type CELExtended struct {
+	// +govalid:cel=value.lowerAscii().matches('^[a-z-]+$') && value.split('-').size() <= 3
+	Slug string `json:"slug"`
+
+	// Fields are present when set, and the conditional operator returns their type
+	// +govalid:cel=has(this.nickname) ? size(value) <= 20 : !this.Anonymous
+	Nickname string `json:"nickname"`
+
+	Anonymous bool `json:"anonymous"`
+
+	// +govalid:cel=this.?owner.orValue(0) < 100 && math.greatest(value, 1) <= 10
+	Level int32 `json:"level"`
+
+	Owner int `json:"owner"`
+
+	// +govalid:cel=value.all(k, k in {'a': 1, 'b': 2}) && sets.contains(['a', 'b'], value.map(k, k))
+	Limits map[string]int `json:"limits"`
+
+	// +govalid:cel=value.getDayOfWeek() != 0 && value.getHours() < 18
+	Opens time.Time `json:"opens"`
+}
@@ -0,0 +1,62 @@
+package celfunc
+
+import (
+	"slices"
+	"strings"
+	"time"
+
+	// The functions of the imported packages are CEL functions; the package of functions
+	// only used by CEL expressions is imported for its side effects
+	_ "celfunc/vat"
+)
+
+//go:generate govalid ./celfunc.go
+
+// Currency is a named type, passed to the function as a string
+type Currency string
+
+// +govalid:celfunc=isCurrency
+func isCurrency(code string) bool {
+	return len(code) == 3 && strings.ToUpper(code) == code
+}
+
+// The name of the CEL function defaults to the name of the Go function
+//
+// +govalid:celfunc
+func businessDays(from, to time.Time) int {
+	return int(to.Sub(from).Hours() / 24)
+}
+
+// +govalid:celfunc=allowed
+func allowedRoles(roles []string, allowed []string) bool {
+	for _, role := range roles {
+		if !slices.Contains(allowed, role) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Functions whose signature has no CEL equivalent are only reported when called
+//
+// +govalid:celfunc=lookup
+func lookup(id *int) bool {
+	return id != nil
+}
+
+type Invoice struct {
+	// +govalid:cel=isValidVAT(value)
+	VATNumber string
+
+	// +govalid:cel=isCurrency(value) && value != 'XXX'
+	Currency Currency
+
+	// +govalid:cel=businessDays(this.Issued, value) >= 1 && businessDays(this.Issued, value) <= 30
+	Due time.Time
+
+	Issued time.Time
+
+	// +govalid:cel=allowed(value, ['admin', 'billing'])
+	Roles []string
+}
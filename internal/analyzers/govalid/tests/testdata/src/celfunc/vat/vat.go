@@ -0,0 +1,17 @@
+package vat
+
+import "strings"
+
+// IsValid reports whether number is a VAT identification number, led by a country code. The function
+// is declared outside the validated package; it reaches the CEL expressions through the facts of the
+// markers analyzer.
+//
+// +govalid:celfunc=isValidVAT
+func IsValid(number string) bool {
+	return len(number) > 4 && strings.ToUpper(number[:2]) == number[:2]
+}
+
+// Unmarked functions are not CEL functions
+func Normalize(number string) string {
+	return strings.ToUpper(number)
+}
@@ -0,0 +1,36 @@
+package conditionalpaths
+
+// Parcel is a struct for testing conditional rules referencing nested, parent and root fields
+// +govalid:fields
+type Parcel struct {
+	Country string `json:"country"`
+	Express bool   `json:"express"`
+
+	Sender *ParcelParty `json:"sender"`
+
+	// +govalid:required_with=Sender.Phone
+	Contact string `json:"contact"`
+
+	// +govalid:dive
+	Recipient ParcelParty `json:"recipient"`
+
+	// +govalid:dive
+	Items []ParcelItem `json:"items"`
+}
+
+// ParcelParty references a field of the parcel containing it
+type ParcelParty struct {
+	Phone string `json:"phone"`
+
+	// +govalid:required_if=..Country US
+	Zip string `json:"zip"`
+}
+
+// ParcelItem references fields of the validated parcel
+type ParcelItem struct {
+	// +govalid:required_unless=$root.Express false $root.Country US
+	Insurance string `json:"insurance"`
+
+	// +govalid:excluded_without=$root.Sender.Phone
+	Fragile string `json:"fragile"`
+}
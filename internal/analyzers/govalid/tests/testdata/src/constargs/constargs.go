@@ -0,0 +1,42 @@
+package constargs
+
+import (
+	lim "constargs/limits"
+)
+
+//go:generate govalid ./constargs.go
+
+const (
+	MinNameLen = 2
+
+	LevelBasic = 1
+	LevelPro   = 3
+
+	CurrencyEUR Currency = "EUR"
+
+	// local is not referenced by the bare word local of the markers
+	local = "remote"
+)
+
+type Currency string
+
+type Account struct {
+	// +govalid:minlength=MinNameLen
+	// +govalid:maxlength=lim.MaxNameLen
+	Name string
+
+	// +govalid:maxitems=lim.MaxTags
+	Tags []string
+
+	// Bare words remain strings, even when they name a constant
+	// +govalid:oneof=lim.DefaultRegion lim.BackupRegion local
+	Region lim.Region
+
+	// +govalid:enum=LevelBasic,2,LevelPro
+	Level int
+
+	// +govalid:lte=lim.MaxRatio
+	Ratio float64
+
+	Currency Currency `validate:"eq=constargs.CurrencyEUR"`
+}
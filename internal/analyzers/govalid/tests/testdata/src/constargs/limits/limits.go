@@ -0,0 +1,14 @@
+package limits
+
+// Region is a deployment region.
+type Region string
+
+const (
+	MaxNameLen = 50
+	MaxTags    = 5
+
+	DefaultRegion Region = "eu-west"
+	BackupRegion  Region = "us-east"
+
+	MaxRatio = 0.75
+)
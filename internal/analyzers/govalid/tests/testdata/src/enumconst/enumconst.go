@@ -0,0 +1,36 @@
+package enumconst
+
+import "enumconst/shipping"
+
+//go:generate govalid ./enumconst.go
+
+// OrderStatus is validated against its constants.
+type OrderStatus string
+
+const (
+	OrderStatusPending   OrderStatus = "pending"
+	OrderStatusShipped   OrderStatus = "shipped"
+	OrderStatusCancelled OrderStatus = "cancelled"
+
+	// OrderStatusDefault has the value of OrderStatusPending and adds no case
+	OrderStatusDefault = OrderStatusPending
+)
+
+// OrderPriority is a numeric type with iota constants.
+type OrderPriority int
+
+const (
+	OrderPriorityLow OrderPriority = iota + 1
+	OrderPriorityNormal
+	OrderPriorityHigh
+)
+
+type Order struct {
+	// +govalid:enum
+	Status OrderStatus
+
+	// +govalid:enum
+	Priority OrderPriority
+
+	Carrier shipping.Carrier `validate:"enum"`
+}
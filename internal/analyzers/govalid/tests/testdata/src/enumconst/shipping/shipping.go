@@ -0,0 +1,12 @@
+package shipping
+
+// Carrier is a shipping carrier declared outside the validated package.
+type Carrier string
+
+const (
+	CarrierPost    Carrier = "post"
+	CarrierCourier Carrier = "courier"
+
+	// carrierTest is unexported and cannot be referenced by other packages
+	carrierTest Carrier = "test"
+)
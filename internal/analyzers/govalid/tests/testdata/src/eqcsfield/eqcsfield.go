@@ -0,0 +1,20 @@
+package eqcsfield
+
+// Transfer is a struct for testing eqcsfield validation
+type Transfer struct {
+	Source TransferAccount `json:"source"`
+
+	// +govalid:dive
+	Details TransferDetails `json:"details"`
+}
+
+// TransferAccount is the account a transfer is made from
+type TransferAccount struct {
+	Currency string `json:"currency"`
+}
+
+// TransferDetails compares its field with a field of another struct of the transfer
+type TransferDetails struct {
+	// +govalid:eqcsfield=Source.Currency
+	Currency string `json:"currency"`
+}
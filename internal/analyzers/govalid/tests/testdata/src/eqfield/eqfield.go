@@ -0,0 +1,15 @@
+package eqfield
+
+// SignupForm is a struct for testing eqfield validation
+type SignupForm struct {
+	Password string `json:"password"`
+
+	// +govalid:eqfield=Password
+	ConfirmPassword string `json:"confirm_password"`
+
+	NewEmail *string `json:"new_email"`
+
+	// Pointers are compared by value when neither is nil
+	// +govalid:eqfield=NewEmail
+	ConfirmEmail *string `json:"confirm_email"`
+}
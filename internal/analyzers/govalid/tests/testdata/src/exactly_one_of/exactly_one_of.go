@@ -0,0 +1,29 @@
+package exactly_one_of
+
+// +govalid:exactly_one_of=CardToken BankAccount Wallet
+type PaymentMethod struct {
+	// +govalid:required
+	Amount int64
+
+	CardToken   string
+	BankAccount *BankAccount
+	Wallet      Wallet
+}
+
+type BankAccount struct {
+	IBAN string
+}
+
+type Wallet struct {
+	Provider string
+	ID       string
+}
+
+// +govalid:exactly_one_of=Pickup Delivery
+// +govalid:exactly_one_of=Card Voucher
+type Order struct {
+	Pickup   string
+	Delivery string
+	Card     string
+	Voucher  string
+}
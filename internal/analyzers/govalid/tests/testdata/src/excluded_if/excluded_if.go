@@ -13,3 +13,12 @@ type Account struct {
 	// +govalid:excluded_if=Plan free
 	CreditCard string `json:"credit_card"`
 }
+
+// Coupon is a struct for testing typed and multiple excluded_if pairs
+type Coupon struct {
+	Percent  float64 `json:"percent"`
+	Stacking bool    `json:"stacking"`
+
+	// +govalid:excluded_if=Percent 100 Stacking false
+	Code string `json:"code"`
+}
@@ -0,0 +1,38 @@
+package expr
+
+import "time"
+
+// MaxHeaders is the maximum number of headers of an ExprHeader.
+const MaxHeaders = 8
+
+// +govalid:expr=!self.Start.IsZero() && self.Start.Before(self.End)
+type ExprWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+type ExprHeader struct {
+	// +govalid:expr=len(self.Tags) <= self.MaxTags && strings.HasPrefix(value, "x-")
+	Name string
+
+	Tags []string
+
+	MaxTags int
+
+	// +govalid:expr=value > 0 && value <= MaxHeaders
+	count int
+
+	Retry struct {
+		// +govalid:expr=value < self.Max || self.Max == 0
+		Attempts int
+
+		Max int
+	}
+
+	// The packages imported by the file qualify identifiers of the expression
+	// +govalid:expr=value > 0 && value <= time.Minute
+	Timeout time.Duration
+}
+
+// +govalid:expr=value != "" && strings.ToLower(string(value)) == string(value)
+type ExprSlug string
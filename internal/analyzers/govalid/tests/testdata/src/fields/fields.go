@@ -0,0 +1,33 @@
+package fields
+
+// +govalid:fields
+type Account struct {
+	// +govalid:required
+	Name string `json:"name"`
+
+	// +govalid:email
+	Email string `json:"email,omitempty"`
+
+	Status string `json:"status"`
+
+	// +govalid:required_if=Status active
+	ActivatedBy string `json:"activated_by"`
+
+	// +govalid:cel=value >= this.MinAge
+	Age int `json:"age"`
+
+	MinAge int `json:"min_age"`
+
+	Address struct {
+		// +govalid:required
+		City string `json:"city"`
+	} `json:"address"`
+
+	// +govalid:dive
+	Items []Item `json:"items"`
+}
+
+type Item struct {
+	// +govalid:maxlength=10
+	Name string `json:"name"`
+}
@@ -0,0 +1,11 @@
+package fileext
+
+import "mime/multipart"
+
+type FileExt struct {
+	// +govalid:fileext=.png .jpg
+	Avatar *multipart.FileHeader `form:"avatar"`
+
+	// +govalid:fileext=pdf docx
+	Attachments []*multipart.FileHeader `form:"attachment"`
+}
@@ -0,0 +1,11 @@
+package filetype
+
+import "mime/multipart"
+
+type FileType struct {
+	// +govalid:filetype=image/png image/jpeg
+	Avatar *multipart.FileHeader `form:"avatar"`
+
+	// +govalid:filetype=image/* application/pdf
+	Attachments []*multipart.FileHeader `form:"attachment"`
+}
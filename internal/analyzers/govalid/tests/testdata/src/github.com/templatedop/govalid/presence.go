@@ -0,0 +1,8 @@
+// Package govalid is a stub of the govalid package for the analyzer tests.
+package govalid
+
+// Presence records which fields of a struct were present in a decoded JSON payload.
+type Presence struct {
+	recorded bool
+	fields   map[string]bool
+}
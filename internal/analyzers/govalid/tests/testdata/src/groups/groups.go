@@ -0,0 +1,15 @@
+package groups
+
+type Member struct {
+	// +govalid:required;groups=update
+	ID string
+
+	// +govalid:required
+	// +govalid:email
+	Email string
+
+	Password string `validate:"required;groups=create,min=8;groups=create update"`
+
+	// +govalid:immutable;groups=update
+	Owner string
+}
@@ -0,0 +1,22 @@
+package gtcsfield
+
+import "time"
+
+// Shipment is a struct for testing gtcsfield validation
+type Shipment struct {
+	Window DeliveryWindow `json:"window"`
+
+	// +govalid:dive
+	Delivery Delivery `json:"delivery"`
+}
+
+// DeliveryWindow is the window of the shipment
+type DeliveryWindow struct {
+	Start time.Time `json:"start"`
+}
+
+// Delivery compares its field with a field of the window of the shipment
+type Delivery struct {
+	// +govalid:gtcsfield=Window.Start
+	At time.Time `json:"at"`
+}
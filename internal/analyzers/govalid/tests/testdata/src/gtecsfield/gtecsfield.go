@@ -0,0 +1,14 @@
+package gtecsfield
+
+// Bid is a struct for testing gtecsfield validation
+type Bid struct {
+	Limits BidLimits `json:"limits"`
+
+	// +govalid:gtecsfield=Limits.Min
+	Amount int `json:"amount"`
+}
+
+// BidLimits are the limits of a bid
+type BidLimits struct {
+	Min int `json:"min"`
+}
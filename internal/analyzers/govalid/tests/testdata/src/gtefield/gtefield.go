@@ -0,0 +1,9 @@
+package gtefield
+
+// PriceRange is a struct for testing gtefield validation
+type PriceRange struct {
+	MinPrice float64 `json:"min_price"`
+
+	// +govalid:gtefield=MinPrice
+	MaxPrice float64 `json:"max_price"`
+}
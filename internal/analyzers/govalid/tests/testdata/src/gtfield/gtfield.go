@@ -0,0 +1,32 @@
+package gtfield
+
+import "time"
+
+// Reservation is a struct for testing gtfield validation
+type Reservation struct {
+	CheckIn time.Time `json:"check_in"`
+
+	// +govalid:gtfield=CheckIn
+	CheckOut time.Time `json:"check_out"`
+
+	LateCheckIn *time.Time `json:"late_check_in"`
+
+	// +govalid:gtfield=LateCheckIn
+	LateCheckOut *time.Time `json:"late_check_out"`
+
+	// +govalid:gtfield=FromNight
+	Nights *int `json:"nights"`
+
+	FromNight int `json:"from_night"`
+
+	// +govalid:dive
+	Rooms []RoomStay `json:"rooms"`
+}
+
+// RoomStay compares sibling fields within the elements of a slice
+type RoomStay struct {
+	FromNight int `json:"from_night"`
+
+	// +govalid:gtfield=FromNight
+	ToNight int `json:"to_night"`
+}
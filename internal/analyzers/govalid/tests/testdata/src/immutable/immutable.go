@@ -0,0 +1,32 @@
+package immutable
+
+type Resource struct {
+	// +govalid:required
+	// +govalid:immutable
+	ID string
+
+	Name string `validate:"immutable"`
+
+	// +govalid:immutable
+	Labels map[string]string
+
+	// +govalid:immutable
+	Owner *string
+
+	// +govalid:cel=value >= oldSelf
+	Generation int
+
+	Spec struct {
+		// +govalid:immutable
+		Kind string
+	}
+
+	// Setting or clearing the volume changes its immutable fields
+	// +govalid:dive
+	Volume *Volume
+}
+
+type Volume struct {
+	// +govalid:immutable
+	Name string
+}
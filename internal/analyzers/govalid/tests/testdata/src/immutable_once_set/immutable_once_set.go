@@ -0,0 +1,20 @@
+package immutable_once_set
+
+type Claim struct {
+	// +govalid:immutable_once_set
+	VolumeName string
+
+	// +govalid:immutable_once_set
+	NodeID int64 `json:"node_id"`
+
+	// +govalid:immutable_once_set
+	Tags []string
+
+	// +govalid:immutable_once_set
+	Binding Binding
+}
+
+type Binding struct {
+	Node string
+	Zone string
+}
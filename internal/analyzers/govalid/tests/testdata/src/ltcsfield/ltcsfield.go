@@ -0,0 +1,22 @@
+package ltcsfield
+
+import "time"
+
+// Conference is a struct for testing ltcsfield validation
+type Conference struct {
+	Schedule *ConferenceSchedule `json:"schedule"`
+
+	// +govalid:dive
+	Sessions []ConferenceSession `json:"sessions"`
+}
+
+// ConferenceSchedule is the schedule of a conference
+type ConferenceSchedule struct {
+	End time.Time `json:"end"`
+}
+
+// ConferenceSession compares its field with the end of the schedule of the conference
+type ConferenceSession struct {
+	// +govalid:ltcsfield=Schedule.End
+	Start time.Time `json:"start"`
+}
@@ -0,0 +1,20 @@
+package ltecsfield
+
+// Cart is a struct for testing ltecsfield validation
+type Cart struct {
+	Budget CartBudget `json:"budget"`
+
+	// +govalid:dive
+	Items []*CartItem `json:"items"`
+}
+
+// CartBudget is the budget of a cart
+type CartBudget struct {
+	PerItem int64 `json:"per_item"`
+}
+
+// CartItem compares its field with the budget of the cart
+type CartItem struct {
+	// +govalid:ltecsfield=Budget.PerItem
+	Price int64 `json:"price"`
+}
@@ -0,0 +1,9 @@
+package ltefield
+
+// Quota is a struct for testing ltefield validation
+type Quota struct {
+	Limit uint `json:"limit"`
+
+	// +govalid:ltefield=Limit
+	Used uint `json:"used"`
+}
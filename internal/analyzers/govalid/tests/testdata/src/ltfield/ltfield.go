@@ -0,0 +1,11 @@
+package ltfield
+
+import "time"
+
+// Timeouts is a struct for testing ltfield validation
+type Timeouts struct {
+	Total time.Duration `json:"total"`
+
+	// +govalid:ltfield=Total
+	Connect time.Duration `json:"connect"`
+}
@@ -0,0 +1,8 @@
+package maxfiles
+
+import "mime/multipart"
+
+type MaxFiles struct {
+	// +govalid:maxfiles=3
+	Attachments []*multipart.FileHeader `form:"attachment"`
+}
@@ -0,0 +1,16 @@
+package maxfilesize
+
+import "mime/multipart"
+
+type MaxFileSize struct {
+	// +govalid:maxfilesize=5MB
+	Avatar *multipart.FileHeader `form:"avatar"`
+
+	// +govalid:maxfilesize=512KB
+	Attachments []*multipart.FileHeader `form:"attachment"`
+
+	// +govalid:maxfilesize=1024
+	Document *multipart.FileHeader `form:"document"`
+
+	Title string `form:"title"`
+}
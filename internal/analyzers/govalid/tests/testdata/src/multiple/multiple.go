@@ -2,7 +2,7 @@
 
 package multiple
 
-// +govalid:required
+// +govalid:required;each
 type Multiple struct {
 	Name string `json:"name"`
 
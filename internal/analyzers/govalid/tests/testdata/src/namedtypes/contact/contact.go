@@ -0,0 +1,26 @@
+package contact
+
+import "namedtypes/contact/region"
+
+// MinPhoneLen is the minimum length of a phone number, referenced by the markers of PhoneNumber.
+const MinPhoneLen = 7
+
+// maxPhoneLen is not exported: the fields of other packages check its value.
+const maxPhoneLen = 15
+
+// PhoneNumber is declared outside the validated package; its markers reach the fields of that
+// type through the facts of the markers analyzer. The constants of its markers resolve in this
+// package.
+//
+// +govalid:numeric
+// +govalid:minlength=MinPhoneLen
+// +govalid:maxlength=maxPhoneLen
+type PhoneNumber string
+
+// +govalid:ne=region.Unknown
+type CountryCode string
+
+// Known reports whether the country code is known.
+func (c CountryCode) Known() bool {
+	return c != region.Unknown
+}
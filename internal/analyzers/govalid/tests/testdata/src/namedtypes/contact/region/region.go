@@ -0,0 +1,4 @@
+package region
+
+// Unknown is the country code of unknown countries.
+const Unknown = "ZZ"
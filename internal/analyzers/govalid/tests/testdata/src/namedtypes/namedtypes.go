@@ -0,0 +1,46 @@
+package namedtypes
+
+import "namedtypes/contact"
+
+//go:generate govalid ./namedtypes.go
+
+// +govalid:email
+type EmailAddress string
+
+// +govalid:minitems=1
+// +govalid:maxitems=5
+type TagList []string
+
+type (
+	// +govalid:gte=1
+	// +govalid:lte=65535
+	PortNumber int
+
+	// Unmarked types generate nothing
+	Nickname string
+)
+
+// MinPhoneLen has the name of the constant of the markers of contact.PhoneNumber, which does not
+// resolve to this one.
+const MinPhoneLen = 3
+
+type Profile struct {
+	Email EmailAddress
+
+	// The markers of the field take precedence over those of its type
+	// +govalid:maxitems=10
+	Tags TagList
+
+	Port PortNumber `validate:"required"`
+
+	Phone contact.PhoneNumber
+
+	Nickname Nickname
+
+	// The markers of the type of a pointer field apply to the value it points to, if any
+	BackupEmail *EmailAddress
+
+	Fax *contact.PhoneNumber
+
+	Country contact.CountryCode
+}
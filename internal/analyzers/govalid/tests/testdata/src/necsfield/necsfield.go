@@ -0,0 +1,14 @@
+package necsfield
+
+// Referral is a struct for testing necsfield validation
+type Referral struct {
+	Referrer *Referrer `json:"referrer"`
+
+	// +govalid:necsfield=Referrer.Email
+	InviteeEmail string `json:"invitee_email"`
+}
+
+// Referrer is optional, the comparison being skipped when it is nil
+type Referrer struct {
+	Email string `json:"email"`
+}
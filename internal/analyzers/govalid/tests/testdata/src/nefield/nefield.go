@@ -0,0 +1,9 @@
+package nefield
+
+// PasswordChange is a struct for testing nefield validation
+type PasswordChange struct {
+	OldPassword string `json:"old_password"`
+
+	// +govalid:nefield=OldPassword
+	NewPassword string `json:"new_password"`
+}
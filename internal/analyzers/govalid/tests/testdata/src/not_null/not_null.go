@@ -0,0 +1,22 @@
+package not_null
+
+import "github.com/templatedop/govalid"
+
+// +govalid:presence
+type Order struct {
+	Presence govalid.Presence `json:"-"`
+
+	// +govalid:required
+	Quantity int `json:"quantity"`
+
+	// +govalid:required
+	// +govalid:nullable
+	Discount *int `json:"discount"`
+
+	// +govalid:not_null
+	Note *string `json:"note,omitempty"`
+
+	Internal string `json:"-"`
+
+	Untagged string
+}
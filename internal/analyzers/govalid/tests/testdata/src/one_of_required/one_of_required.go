@@ -0,0 +1,8 @@
+package one_of_required
+
+// +govalid:one_of_required=Email Phone Addresses
+type ContactInfo struct {
+	Email     string
+	Phone     *string
+	Addresses []string
+}
@@ -0,0 +1,9 @@
+package kinds
+
+// Kind is a named type declared in another package, whose constants are compared by qualified name
+type Kind string
+
+const (
+	KindPerson  Kind = "person"
+	KindCompany Kind = "company"
+)
@@ -1,6 +1,8 @@
 //go:generate ./required_if.go
 package required_if
 
+import k "required_if/kinds"
+
 // Form is a struct for testing required_if validation
 type Form struct {
 	Type string `json:"type"`
@@ -13,3 +15,36 @@ type Form struct {
 	// +govalid:required_if=Status active
 	ActiveField string `json:"active_field"`
 }
+
+// TicketPriority is a named type whose constants are compared by name
+type TicketPriority int
+
+const (
+	TicketPriorityLow TicketPriority = iota
+	TicketPriorityHigh
+)
+
+// Ticket is a struct for testing typed and multiple required_if pairs
+type Ticket struct {
+	Count    int            `json:"count"`
+	Active   bool           `json:"active"`
+	Priority TicketPriority `json:"priority"`
+	Region   *string        `json:"region"`
+
+	// +govalid:required_if=Count 3
+	Reason string `json:"reason"`
+
+	// +govalid:required_if=Active true Priority TicketPriorityHigh
+	Assignee string `json:"assignee"`
+
+	// +govalid:required_if=Region "eu west"
+	DataOfficer string `json:"data_officer"`
+}
+
+// Account is a struct for testing values naming constants of an imported package
+type Account struct {
+	Kind k.Kind `json:"kind"`
+
+	// +govalid:required_if=Kind k.KindCompany
+	VATNumber string `json:"vat_number"`
+}
@@ -13,3 +13,16 @@ type Payment struct {
 	// +govalid:required_unless=AccountType guest
 	AccountID string `json:"account_id"`
 }
+
+// Subscription is a struct for testing typed and multiple required_unless pairs
+type Subscription struct {
+	Trial bool   `json:"trial"`
+	Seats uint   `json:"seats"`
+	Plan  string `json:"plan"`
+
+	// +govalid:required_unless=Trial true Plan free
+	BillingEmail string `json:"billing_email"`
+
+	// +govalid:required_unless=Seats 1
+	TeamName string `json:"team_name"`
+}
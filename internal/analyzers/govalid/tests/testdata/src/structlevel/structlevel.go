@@ -0,0 +1,23 @@
+package structlevel
+
+// +govalid:fields
+// +govalid:cel=self.Start < self.End
+type Booking struct {
+	// +govalid:required
+	Room string `json:"room"`
+
+	Start int `json:"start"`
+	End   int `json:"end"`
+}
+
+// +govalid:required;each
+// +govalid:cel=value > 0;each
+type Dimensions struct {
+	Width  int
+	Height int
+}
+
+// +govalid:cel=self.Revision >= oldSelf.Revision
+type Revisioned struct {
+	Revision int
+}
@@ -0,0 +1,26 @@
+package unmarshal
+
+import "github.com/templatedop/govalid"
+
+// +govalid:unmarshal
+type User struct {
+	// +govalid:required
+	Name string `json:"name"`
+
+	// +govalid:email
+	Email string `json:"email"`
+}
+
+// +govalid:unmarshal
+// +govalid:presence
+type Order struct {
+	Presence govalid.Presence `json:"-"`
+
+	// +govalid:required
+	Quantity int `json:"quantity"`
+}
+
+// +govalid:unmarshal
+type Empty struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,29 @@
+package when
+
+// Applicant is a struct for testing guarded rules
+type Applicant struct {
+	Country string `json:"country"`
+	Type    string `json:"type"`
+	Age     int    `json:"age"`
+
+	Zip string `json:"zip" validate:"when=Country==US,len=5,numeric"`
+
+	// +govalid:if=self.Type == 'business' && self.Age >= 18
+	// +govalid:required
+	// +govalid:maxlength=50
+	Company string `json:"company"`
+
+	// +govalid:when=Age < 18 && Country != US
+	// +govalid:required;groups=minor
+	Guardian string `json:"guardian"`
+
+	// +govalid:dive
+	Referees []Referee `json:"referees"`
+}
+
+// Referee guards its rules with a field of the applicant
+type Referee struct {
+	// +govalid:when=$root.Type==business
+	// +govalid:email
+	Email string `json:"email"`
+}
@@ -4,6 +4,7 @@ import (
 	"go/ast"
 	"go/types"
 	"reflect"
+	"slices"
 	"strings"
 
 	"golang.org/x/tools/go/analysis"
@@ -18,6 +19,10 @@ const (
 	Name = "markers"
 	// Doc is the documentation for the markers analyzer.
 	Doc = "markers is a helper for generating govalid validation"
+
+	// CELFunctionMarker declares a Go function as a function of the CEL expressions, named by the value
+	// of the marker or else by the name of the Go function, e.g., +govalid:celfunc=isValidVAT.
+	CELFunctionMarker = "govalid:celfunc"
 )
 
 // Analyzer is the main entry point for the markers analyzer.
@@ -53,6 +58,7 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 
 	nodeFilter := []ast.Node{
 		(*ast.GenDecl)(nil),
+		(*ast.FuncDecl)(nil),
 	}
 
 	results, ok := newMarkers().(*markers)
@@ -67,9 +73,7 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 				return
 			}
 
-			if n.Doc != nil && len(n.Doc.List) > 0 {
-				collectTypeMarkers(pass, n, results)
-			}
+			collectTypeMarkers(pass, n, results)
 
 			for _, spec := range n.Specs {
 				ts, ok := spec.(*ast.TypeSpec)
@@ -79,52 +83,180 @@ func (a *analyzer) run(pass *analysis.Pass) (any, error) {
 
 				st, ok := ts.Type.(*ast.StructType)
 				if !ok {
-					return
+					continue
 				}
 
 				collectStructMarkers(pass, st, results)
 			}
+		case *ast.FuncDecl:
+			collectCELFunction(pass, n, results)
 		default:
 		}
 	})
 
+	importCELFunctions(pass, results)
+
 	return results, nil
 }
 
+// collectCELFunction declares the function fn as a CEL function when it is marked with +govalid:celfunc,
+// exporting the marker as a fact of the function for the packages importing it. Methods cannot be CEL
+// functions.
+func collectCELFunction(pass *analysis.Pass, fn *ast.FuncDecl, results *markers) {
+	if fn.Doc == nil || fn.Recv != nil {
+		return
+	}
+
+	obj, ok := pass.TypesInfo.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return
+	}
+
+	for _, doc := range fn.Doc.List {
+		if !strings.HasPrefix(doc.Text, "// +") {
+			continue
+		}
+
+		identifier, expressions := extractMarker(strings.TrimPrefix(doc.Text, "// +"))
+		if identifier != CELFunctionMarker {
+			continue
+		}
+
+		name := strings.TrimSpace(expressions[identifier])
+		if name == "" {
+			name = fn.Name.Name
+		}
+
+		results.insertCELFunction(name, obj)
+		exportMarkerFact(pass, obj, Marker{
+			Identifier:  identifier,
+			Expressions: map[string]string{identifier: name},
+		})
+	}
+}
+
+// importCELFunctions declares the exported functions of the imported packages marked with
+// +govalid:celfunc, reading their markers from the MarkerFact of the functions.
+func importCELFunctions(pass *analysis.Pass, results *markers) {
+	for _, pkg := range pass.Pkg.Imports() {
+		for _, name := range pkg.Scope().Names() {
+			obj, ok := pkg.Scope().Lookup(name).(*types.Func)
+			if !ok || !obj.Exported() {
+				continue
+			}
+
+			var fact MarkerFact
+			if !pass.ImportObjectFact(obj, &fact) {
+				continue
+			}
+
+			for _, marker := range fact.Markers {
+				if marker.Identifier == CELFunctionMarker {
+					results.insertCELFunction(marker.Expressions[CELFunctionMarker], obj)
+				}
+			}
+		}
+	}
+}
+
 // collectTypeMarkers collects markers from a GenDecl node and adds them to the results.
+// The markers documenting a group of types apply to all of them, and those documenting
+// a type of the group only to that type.
 func collectTypeMarkers(pass *analysis.Pass, genDecl *ast.GenDecl, results *markers) {
-	if genDecl.Doc == nil || len(genDecl.Doc.List) == 0 {
+	for _, spec := range genDecl.Specs {
+		ts, ok := spec.(*ast.TypeSpec)
+		if !ok {
+			continue
+		}
+
+		typeSpecMarkers(pass, ts, genDecl.Doc, results)
+		typeSpecMarkers(pass, ts, ts.Doc, results)
+	}
+}
+
+// typeSpecMarkers collects the markers of doc documenting ts. The markers of named non-struct
+// types, such as type Email string, are also recorded as named type markers, inherited by
+// the fields of that type.
+func typeSpecMarkers(pass *analysis.Pass, ts *ast.TypeSpec, doc *ast.CommentGroup, results *markers) {
+	if doc == nil || len(doc.List) == 0 {
 		return
 	}
 
-	for _, doc := range genDecl.Doc.List {
+	obj := pass.TypesInfo.Defs[ts.Name]
+
+	for _, doc := range doc.List {
 		if !strings.HasPrefix(doc.Text, "// +") {
 			continue
 		}
 
-		markerContent := strings.TrimPrefix(doc.Text, "// +")
+		markerContent, options := extractOptions(strings.TrimPrefix(doc.Text, "// +"))
 
 		identifier, expressions := extractMarker(markerContent)
 		marker := Marker{
 			Identifier:  identifier,
 			Expressions: expressions,
+			Groups:      options.groups,
+			Each:        options.each,
 		}
 
-		for _, spec := range genDecl.Specs {
-			if ts, ok := spec.(*ast.TypeSpec); ok {
-				results.insertTypeMarker(ts, marker)
+		results.insertTypeMarker(ts, marker)
 
-				if obj, ok := pass.TypesInfo.Defs[ts.Name]; ok {
-					pass.ExportObjectFact(obj, &MarkerFact{
-						Identifier:  identifier,
-						Expressions: expressions,
-					})
-				}
-			}
+		if obj == nil {
+			continue
+		}
+
+		exportMarkerFact(pass, obj, marker)
+
+		if tn, ok := obj.(*types.TypeName); ok && !isStructType(tn) {
+			results.insertNamedTypeMarker(tn, marker)
 		}
 	}
 }
 
+// exportMarkerFact exports the MarkerFact of marker for obj, carrying the markers exported
+// for obj so far, so that the last fact holds all the markers of obj.
+func exportMarkerFact(pass *analysis.Pass, obj types.Object, marker Marker) {
+	var previous MarkerFact
+	pass.ImportObjectFact(obj, &previous)
+
+	markers := slices.Clone(previous.Markers)
+	markers.Add(marker)
+
+	pass.ExportObjectFact(obj, &MarkerFact{
+		Identifier:  marker.Identifier,
+		Expressions: marker.Expressions,
+		Groups:      marker.Groups,
+		Each:        marker.Each,
+		Markers:     markers,
+	})
+}
+
+// importNamedTypeMarkers records the markers of the named non-struct type of field when the type
+// is declared in another package, reading them from the MarkerFact of the type.
+func importNamedTypeMarkers(pass *analysis.Pass, field *ast.Field, results *markers) {
+	named, ok := types.Unalias(pass.TypesInfo.TypeOf(field.Type)).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil || named.Obj().Pkg() == pass.Pkg || isStructType(named.Obj()) {
+		return
+	}
+
+	var fact MarkerFact
+	if !pass.ImportObjectFact(named.Obj(), &fact) {
+		return
+	}
+
+	for _, marker := range fact.Markers {
+		results.insertNamedTypeMarker(named.Obj(), marker)
+	}
+}
+
+// isStructType reports whether tn is a struct type, whose markers apply to the struct
+// rather than to the fields of that type.
+func isStructType(tn *types.TypeName) bool {
+	_, ok := tn.Type().Underlying().(*types.Struct)
+
+	return ok
+}
+
 // collectStructMarkers collects markers from a TypeSpec node and adds them to the results.
 func collectStructMarkers(pass *analysis.Pass, s *ast.StructType, results *markers) {
 	if s == nil || s.Fields == nil || len(s.Fields.List) == 0 {
@@ -144,11 +276,19 @@ func collectStructMarkers(pass *analysis.Pass, s *ast.StructType, results *marke
 }
 
 // fieldMarkers extracts markers from a struct field and adds them to the results.
+// commentAliases maps the identifiers of comment markers to the markers they stand for.
+var commentAliases = map[string]string{
+	// +govalid:if reads better than +govalid:when in comments
+	"govalid:if": "govalid:when",
+}
+
 func fieldMarkers(pass *analysis.Pass, field *ast.Field, results *markers) {
 	if field == nil || len(field.Names) == 0 {
 		return
 	}
 
+	importNamedTypeMarkers(pass, field, results)
+
 	// Support legacy comment-based markers for backward compatibility.
 	if field.Doc != nil && len(field.Doc.List) > 0 {
 		for _, doc := range field.Doc.List {
@@ -156,20 +296,22 @@ func fieldMarkers(pass *analysis.Pass, field *ast.Field, results *markers) {
 				continue
 			}
 
-			markerContent := strings.TrimPrefix(doc.Text, "// +")
+			markerContent, options := extractOptions(strings.TrimPrefix(doc.Text, "// +"))
 
 			identifier, expressions := extractMarker(markerContent)
+			if alias, ok := commentAliases[identifier]; ok {
+				identifier, expressions = alias, map[string]string{alias: expressions[identifier]}
+			}
+
 			marker := Marker{
 				Identifier:  identifier,
 				Expressions: expressions,
+				Groups:      options.groups,
 			}
 			results.insertFieldMarker(field, marker)
 
 			if obj, ok := pass.TypesInfo.Defs[field.Names[0]]; ok {
-				pass.ExportObjectFact(obj, &MarkerFact{
-					Identifier:  identifier,
-					Expressions: expressions,
-				})
+				exportMarkerFact(pass, obj, marker)
 			}
 		}
 	}
@@ -193,7 +335,7 @@ func fieldMarkers(pass *analysis.Pass, field *ast.Field, results *markers) {
 	// Split validators by comma, e.g. `required,email,lt=10,max=5`
 	tokens := strings.Split(validateRaw, ",")
 	for _, tok := range tokens {
-		v := strings.TrimSpace(tok)
+		v, options := extractOptions(strings.TrimSpace(tok))
 		if v == "" {
 			continue
 		}
@@ -203,11 +345,11 @@ func fieldMarkers(pass *analysis.Pass, field *ast.Field, results *markers) {
 			continue
 		}
 
-		marker := Marker{Identifier: identifier, Expressions: expressions}
+		marker := Marker{Identifier: identifier, Expressions: expressions, Groups: options.groups}
 		results.insertFieldMarker(field, marker)
 
 		if obj, ok := pass.TypesInfo.Defs[field.Names[0]]; ok {
-			pass.ExportObjectFact(obj, &MarkerFact{Identifier: identifier, Expressions: expressions})
+			exportMarkerFact(pass, obj, marker)
 		}
 	}
 }
@@ -248,7 +390,7 @@ func normalizeValidateToken(pass *analysis.Pass, field *ast.Field, token string)
 	// Map synonyms to internal marker names.
 	switch keyLower {
 	// Original validators
-	case "required", "email", "uuid", "url", "numeric", "ipv4", "ipv6", "alpha", "enum", "cel", "lt", "lte", "gt", "gte", "length", "date", "dive":
+	case "required", "email", "uuid", "url", "numeric", "ipv4", "ipv6", "alpha", "enum", "cel", "expr", "lt", "lte", "gt", "gte", "length", "date", "dive":
 		// direct mapping
 	// New simple validators
 	case "eq", "ne", "isdefault", "boolean", "lowercase", "oneof", "number", "alphanum":
@@ -271,6 +413,24 @@ func normalizeValidateToken(pass *analysis.Pass, field *ast.Field, token string)
 	// Conditional required validators
 	case "required_if", "required_unless", "required_with", "required_with_all", "required_without", "required_without_all":
 		// direct mapping
+	// File upload validators
+	case "maxfilesize", "maxfiles", "filetype", "fileext":
+		// direct mapping
+	// Presence validators, see +govalid:presence
+	case "nullable", "not_null":
+		// direct mapping
+	// Transition validators, see Validate{{Type}}Update
+	case "immutable", "immutable_once_set":
+		// direct mapping
+	// Guard of the other rules of the field, see +govalid:if
+	case "when":
+		// direct mapping
+	case "if":
+		keyLower = "when"
+	// Cross-field comparison validators
+	case "eqfield", "nefield", "gtfield", "gtefield", "ltfield", "ltefield",
+		"eqcsfield", "necsfield", "gtcsfield", "gtecsfield", "ltcsfield", "ltecsfield":
+		// direct mapping
 	// Conditional excluded validators
 	case "excluded_if", "excluded_unless", "excluded_with", "excluded_with_all", "excluded_without", "excluded_without_all":
 		// direct mapping
@@ -308,6 +468,45 @@ func normalizeValidateToken(pass *analysis.Pass, field *ast.Field, token string)
 	return identifier, expressions
 }
 
+// markerOptions are the options following the rule of a marker, separated by semicolons,
+// e.g., +govalid:required;groups=create,update or +govalid:required;each.
+type markerOptions struct {
+	groups []string
+	each   bool
+}
+
+// extractOptions splits the options off the marker content, returning the content without them.
+// The groups are separated by commas or spaces; tags use spaces, as commas separate their rules,
+// e.g., `validate:"required;groups=create update,email"`.
+func extractOptions(content string) (string, markerOptions) {
+	var options markerOptions
+
+	for {
+		i := strings.LastIndex(content, ";")
+		if i < 0 {
+			break
+		}
+
+		name, value, _ := strings.Cut(content[i+1:], "=")
+
+		switch strings.TrimSpace(name) {
+		case "groups":
+			options.groups = strings.FieldsFunc(value, func(r rune) bool {
+				return r == ',' || r == ' '
+			})
+		case "each":
+			options.each = true
+		default:
+			// Not an option, e.g., a semicolon within an expression
+			return content, options
+		}
+
+		content = strings.TrimSpace(content[:i])
+	}
+
+	return content, options
+}
+
 // extractMarker extracts the identifier and expressions from a marker content string.
 // It returns the identifier and a map of expressions if applicable.
 // If the content does not contain an identifier or expressions, it returns an empty string and nil.
@@ -9,6 +9,12 @@ import (
 type MarkerFact struct {
 	Identifier  string
 	Expressions map[string]string
+	Groups      []string
+	Each        bool
+	// Markers are all the markers of the object, including this one, as a fact replaces
+	// the previous fact of the object. They carry the markers of named types to the
+	// packages using them.
+	Markers MarkerSet
 }
 
 // AFact is a method that satisfies the Fact interface.
@@ -34,5 +40,15 @@ func (mf *MarkerFact) String() string {
 
 	quotedIdentifier := fmt.Sprintf("%q", mf.Identifier)
 
-	return fmt.Sprintf("Identifier: %s, Expressions: {%s}", quotedIdentifier, expressionsString)
+	s := fmt.Sprintf("Identifier: %s, Expressions: {%s}", quotedIdentifier, expressionsString)
+
+	if len(mf.Groups) > 0 {
+		s += fmt.Sprintf(", Groups: [%s]", strings.Join(mf.Groups, " "))
+	}
+
+	if mf.Each {
+		s += ", Each"
+	}
+
+	return s
 }
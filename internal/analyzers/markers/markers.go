@@ -2,12 +2,31 @@ package markers
 
 import (
 	"go/ast"
+	"go/types"
 )
 
 // Marker represents a single marker with an identifier and associated expressions.
 type Marker struct {
 	Identifier  string
 	Expressions map[string]string
+	// Groups are the validation groups the rule belongs to, set with the groups option,
+	// e.g., +govalid:required;groups=create,update. Rules without groups always run.
+	Groups []string
+	// Each applies a type marker to each field of the struct, set with the each option,
+	// e.g., +govalid:required;each. Without it, the struct-level rules such as cel
+	// validate the struct as a whole.
+	Each bool
+
+	// declaredBy is the named non-struct type declaring the marker, see DeclaredBy. It is not part
+	// of the facts, being set when the markers of the type are recorded in the package using them.
+	declaredBy *types.TypeName
+}
+
+// DeclaredBy returns the named non-struct type declaring the marker, which its fields inherit,
+// or nil for the markers declared on fields and structs. The arguments of an inherited marker,
+// such as constants, resolve in the package of that type.
+func (m Marker) DeclaredBy() *types.TypeName {
+	return m.declaredBy
 }
 
 // MarkerSet is an ordered collection of markers that preserves definition order.
@@ -33,6 +52,23 @@ func (ms *MarkerSet) Add(marker Marker) {
 	*ms = append(*ms, marker)
 }
 
+// addTypeMarker returns the set with the type marker added. The markers of struct-level rules are
+// kept when repeated, while the other markers replace the previous marker of the rule applying to
+// each field.
+func (ms MarkerSet) addTypeMarker(marker Marker) MarkerSet {
+	if !IsStructLevel(marker) {
+		for i, existing := range ms {
+			if existing.Identifier == marker.Identifier && !IsStructLevel(existing) {
+				ms[i] = marker
+
+				return ms
+			}
+		}
+	}
+
+	return append(ms, marker)
+}
+
 // Markers is an interface that provides methods to retrieve markers for struct fields.
 type Markers interface {
 	// FieldMarkers returns markers for struct fields.
@@ -40,6 +76,14 @@ type Markers interface {
 
 	// TypeMarkers returns markers for struct types.
 	TypeMarkers(*ast.TypeSpec) MarkerSet
+
+	// NamedTypeMarkers returns the markers of a named non-struct type, declared in the package
+	// or in an imported one, which apply to every field of that type.
+	NamedTypeMarkers(*types.TypeName) MarkerSet
+
+	// CELFunctions returns the Go functions declared as CEL functions with +govalid:celfunc, in the
+	// package or in the packages it imports, by CEL function name.
+	CELFunctions() map[string]*types.Func
 }
 
 // newMarkers creates a new instance of Markers, initializing the internal map for field markers.
@@ -47,6 +91,8 @@ func newMarkers() Markers {
 	return &markers{
 		fieldMarkers: make(map[*ast.Field]MarkerSet),
 		typeMarkers:  make(map[*ast.TypeSpec]MarkerSet),
+		namedMarkers: make(map[*types.TypeName]MarkerSet),
+		celFunctions: make(map[string]*types.Func),
 	}
 }
 
@@ -54,6 +100,8 @@ func newMarkers() Markers {
 type markers struct {
 	fieldMarkers map[*ast.Field]MarkerSet
 	typeMarkers  map[*ast.TypeSpec]MarkerSet
+	namedMarkers map[*types.TypeName]MarkerSet
+	celFunctions map[string]*types.Func
 }
 
 // FieldMarkers retrieves the markers for a given struct field.
@@ -66,6 +114,16 @@ func (m *markers) TypeMarkers(ts *ast.TypeSpec) MarkerSet {
 	return m.typeMarkers[ts]
 }
 
+// NamedTypeMarkers retrieves the markers for a given named non-struct type.
+func (m *markers) NamedTypeMarkers(tn *types.TypeName) MarkerSet {
+	return m.namedMarkers[tn]
+}
+
+// CELFunctions retrieves the Go functions declared as CEL functions.
+func (m *markers) CELFunctions() map[string]*types.Func {
+	return m.celFunctions
+}
+
 // insertFieldMarker adds a marker to a specific struct field.
 func (m *markers) insertFieldMarker(field *ast.Field, marker Marker) {
 	if existing, ok := m.fieldMarkers[field]; ok {
@@ -80,16 +138,44 @@ func (m *markers) insertFieldMarker(field *ast.Field, marker Marker) {
 	m.fieldMarkers[field] = ms
 }
 
-// insertTypeMarkers adds a set of markers to a struct type.
+// structLevelMarkers are the rules validating the struct as a whole when declared on its type,
+// e.g., +govalid:cel=self.Start < self.End, which may be repeated to declare several rules, such as
+// independent groups of fields with +govalid:exactly_one_of.
+var structLevelMarkers = map[string]bool{
+	"govalid:cel":             true,
+	"govalid:expr":            true,
+	"govalid:one_of_required": true,
+	"govalid:exactly_one_of":  true,
+	"govalid:at_most_one_of":  true,
+	"govalid:all_or_none_of":  true,
+}
+
+// IsStructLevel reports whether the marker declares a struct-level rule when declared on a type
+// without the each option.
+func IsStructLevel(marker Marker) bool {
+	return !marker.Each && structLevelMarkers[marker.Identifier]
+}
+
+// insertTypeMarker adds a marker to a struct type, see addTypeMarker.
 func (m *markers) insertTypeMarker(ts *ast.TypeSpec, marker Marker) {
-	if existing, ok := m.typeMarkers[ts]; ok {
-		existing.Add(marker)
-		m.typeMarkers[ts] = existing
+	m.typeMarkers[ts] = m.typeMarkers[ts].addTypeMarker(marker)
+}
 
+// insertNamedTypeMarker adds a marker to a named non-struct type.
+func (m *markers) insertNamedTypeMarker(tn *types.TypeName, marker Marker) {
+	marker.declaredBy = tn
+
+	ms := m.namedMarkers[tn]
+	ms.Add(marker)
+	m.namedMarkers[tn] = ms
+}
+
+// insertCELFunction declares fn as the CEL function name, unless a function of that name is already
+// declared, as the functions of the package are collected before those of its imports.
+func (m *markers) insertCELFunction(name string, fn *types.Func) {
+	if _, ok := m.celFunctions[name]; ok {
 		return
 	}
 
-	ms := newMarkerSet()
-	ms.Add(marker)
-	m.typeMarkers[ts] = ms
+	m.celFunctions[name] = fn
 }
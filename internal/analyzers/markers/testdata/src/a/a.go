@@ -11,3 +11,35 @@ type TypeLevelMarkers struct {
 	RequiredString int    `validate:"required"` // want RequiredString:`Identifier: "govalid:required", Expressions: {no expressions}`
 	Email          string `validate:"email"`    // want Email:`Identifier: "govalid:email", Expressions: {no expressions}`
 }
+
+type GroupMarkers struct {
+	// +govalid:required;groups=create,update
+	Name  string // want Name:`Identifier: "govalid:required", Expressions: {no expressions}, Groups: \[create update\]`
+	Email string `validate:"required;groups=create,email"` // want Email:`Identifier: "govalid:required", Expressions: {no expressions}, Groups: \[create\]` // want Email:`Identifier: "govalid:email", Expressions: {no expressions}`
+}
+
+// +govalid:required;each
+type EachMarkers struct { // want EachMarkers:`Identifier: "govalid:required", Expressions: {no expressions}, Each`
+	Name string
+}
+
+// +govalid:cel=self.Start < self.End;groups=create
+type StructMarkers struct { // want StructMarkers:`Identifier: "govalid:cel", Expressions: {govalid:cel: self.Start < self.End}, Groups: \[create\]`
+	Start int
+	End   int
+}
+
+type GuardMarkers struct {
+	// +govalid:if=self.Age >= 18
+	Name string // want Name:`Identifier: "govalid:when", Expressions: {govalid:when: self.Age >= 18}`
+	Zip  string `validate:"when=Country==US"` // want Zip:`Identifier: "govalid:when", Expressions: {govalid:when: Country==US}`
+}
+
+// +govalid:email
+// +govalid:maxlength=64
+type NamedEmail string // want NamedEmail:`Identifier: "govalid:maxlength", Expressions: {govalid:maxlength: 64}`
+
+type (
+	// +govalid:minitems=1
+	NamedTags []string // want NamedTags:`Identifier: "govalid:minitems", Expressions: {govalid:minitems: 1}`
+)
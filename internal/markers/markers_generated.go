@@ -2,12 +2,18 @@
 package markers
 
 var (
+	// GoValidMarkerAll_or_none_of is the marker for all_or_none_of validation.
+	GoValidMarkerAll_or_none_of = "govalid:all_or_none_of"
+
 	// GoValidMarkerAlpha is the marker for alpha validation.
 	GoValidMarkerAlpha = "govalid:alpha"
 
 	// GoValidMarkerAlphanum is the marker for alphanum validation.
 	GoValidMarkerAlphanum = "govalid:alphanum"
 
+	// GoValidMarkerAt_most_one_of is the marker for at_most_one_of validation.
+	GoValidMarkerAt_most_one_of = "govalid:at_most_one_of"
+
 	// GoValidMarkerBoolean is the marker for boolean validation.
 	GoValidMarkerBoolean = "govalid:boolean"
 
@@ -29,6 +35,15 @@ var (
 	// GoValidMarkerEq is the marker for eq validation.
 	GoValidMarkerEq = "govalid:eq"
 
+	// GoValidMarkerEqcsfield is the marker for eqcsfield validation.
+	GoValidMarkerEqcsfield = "govalid:eqcsfield"
+
+	// GoValidMarkerEqfield is the marker for eqfield validation.
+	GoValidMarkerEqfield = "govalid:eqfield"
+
+	// GoValidMarkerExactly_one_of is the marker for exactly_one_of validation.
+	GoValidMarkerExactly_one_of = "govalid:exactly_one_of"
+
 	// GoValidMarkerExcluded_if is the marker for excluded_if validation.
 	GoValidMarkerExcluded_if = "govalid:excluded_if"
 
@@ -53,15 +68,42 @@ var (
 	// GoValidMarkerExcludesall is the marker for excludesall validation.
 	GoValidMarkerExcludesall = "govalid:excludesall"
 
+	// GoValidMarkerExpr is the marker for expr validation.
+	GoValidMarkerExpr = "govalid:expr"
+
+	// GoValidMarkerFileext is the marker for fileext validation.
+	GoValidMarkerFileext = "govalid:fileext"
+
+	// GoValidMarkerFiletype is the marker for filetype validation.
+	GoValidMarkerFiletype = "govalid:filetype"
+
 	// GoValidMarkerFqdn is the marker for fqdn validation.
 	GoValidMarkerFqdn = "govalid:fqdn"
 
 	// GoValidMarkerGt is the marker for gt validation.
 	GoValidMarkerGt = "govalid:gt"
 
+	// GoValidMarkerGtcsfield is the marker for gtcsfield validation.
+	GoValidMarkerGtcsfield = "govalid:gtcsfield"
+
 	// GoValidMarkerGte is the marker for gte validation.
 	GoValidMarkerGte = "govalid:gte"
 
+	// GoValidMarkerGtecsfield is the marker for gtecsfield validation.
+	GoValidMarkerGtecsfield = "govalid:gtecsfield"
+
+	// GoValidMarkerGtefield is the marker for gtefield validation.
+	GoValidMarkerGtefield = "govalid:gtefield"
+
+	// GoValidMarkerGtfield is the marker for gtfield validation.
+	GoValidMarkerGtfield = "govalid:gtfield"
+
+	// GoValidMarkerImmutable is the marker for immutable validation.
+	GoValidMarkerImmutable = "govalid:immutable"
+
+	// GoValidMarkerImmutable_once_set is the marker for immutable_once_set validation.
+	GoValidMarkerImmutable_once_set = "govalid:immutable_once_set"
+
 	// GoValidMarkerIpv4 is the marker for ipv4 validation.
 	GoValidMarkerIpv4 = "govalid:ipv4"
 
@@ -89,12 +131,30 @@ var (
 	// GoValidMarkerLt is the marker for lt validation.
 	GoValidMarkerLt = "govalid:lt"
 
+	// GoValidMarkerLtcsfield is the marker for ltcsfield validation.
+	GoValidMarkerLtcsfield = "govalid:ltcsfield"
+
 	// GoValidMarkerLte is the marker for lte validation.
 	GoValidMarkerLte = "govalid:lte"
 
+	// GoValidMarkerLtecsfield is the marker for ltecsfield validation.
+	GoValidMarkerLtecsfield = "govalid:ltecsfield"
+
+	// GoValidMarkerLtefield is the marker for ltefield validation.
+	GoValidMarkerLtefield = "govalid:ltefield"
+
+	// GoValidMarkerLtfield is the marker for ltfield validation.
+	GoValidMarkerLtfield = "govalid:ltfield"
+
 	// GoValidMarkerMaxduration is the marker for maxduration validation.
 	GoValidMarkerMaxduration = "govalid:maxduration"
 
+	// GoValidMarkerMaxfiles is the marker for maxfiles validation.
+	GoValidMarkerMaxfiles = "govalid:maxfiles"
+
+	// GoValidMarkerMaxfilesize is the marker for maxfilesize validation.
+	GoValidMarkerMaxfilesize = "govalid:maxfilesize"
+
 	// GoValidMarkerMaxitems is the marker for maxitems validation.
 	GoValidMarkerMaxitems = "govalid:maxitems"
 
@@ -116,12 +176,24 @@ var (
 	// GoValidMarkerNe is the marker for ne validation.
 	GoValidMarkerNe = "govalid:ne"
 
+	// GoValidMarkerNecsfield is the marker for necsfield validation.
+	GoValidMarkerNecsfield = "govalid:necsfield"
+
+	// GoValidMarkerNefield is the marker for nefield validation.
+	GoValidMarkerNefield = "govalid:nefield"
+
+	// GoValidMarkerNot_null is the marker for not_null validation.
+	GoValidMarkerNot_null = "govalid:not_null"
+
 	// GoValidMarkerNumber is the marker for number validation.
 	GoValidMarkerNumber = "govalid:number"
 
 	// GoValidMarkerNumeric is the marker for numeric validation.
 	GoValidMarkerNumeric = "govalid:numeric"
 
+	// GoValidMarkerOne_of_required is the marker for one_of_required validation.
+	GoValidMarkerOne_of_required = "govalid:one_of_required"
+
 	// GoValidMarkerOneof is the marker for oneof validation.
 	GoValidMarkerOneof = "govalid:oneof"
 
@@ -158,12 +230,17 @@ var (
 	// GoValidMarkerUuid is the marker for uuid validation.
 	GoValidMarkerUuid = "govalid:uuid"
 
+	// GoValidMarkerWhen is the marker for when validation.
+	GoValidMarkerWhen = "govalid:when"
+
 )
 
 // GoValidMarkers is a map of valid govalid markers.
 var GoValidMarkers = map[string]struct{}{
+	GoValidMarkerAll_or_none_of: {},
 	GoValidMarkerAlpha: {},
 	GoValidMarkerAlphanum: {},
+	GoValidMarkerAt_most_one_of: {},
 	GoValidMarkerBoolean: {},
 	GoValidMarkerCel: {},
 	GoValidMarkerContainsany: {},
@@ -171,6 +248,9 @@ var GoValidMarkers = map[string]struct{}{
 	GoValidMarkerEmail: {},
 	GoValidMarkerEnum: {},
 	GoValidMarkerEq: {},
+	GoValidMarkerEqcsfield: {},
+	GoValidMarkerEqfield: {},
+	GoValidMarkerExactly_one_of: {},
 	GoValidMarkerExcluded_if: {},
 	GoValidMarkerExcluded_unless: {},
 	GoValidMarkerExcluded_with: {},
@@ -179,9 +259,18 @@ var GoValidMarkers = map[string]struct{}{
 	GoValidMarkerExcluded_without_all: {},
 	GoValidMarkerExcludes: {},
 	GoValidMarkerExcludesall: {},
+	GoValidMarkerExpr: {},
+	GoValidMarkerFileext: {},
+	GoValidMarkerFiletype: {},
 	GoValidMarkerFqdn: {},
 	GoValidMarkerGt: {},
+	GoValidMarkerGtcsfield: {},
 	GoValidMarkerGte: {},
+	GoValidMarkerGtecsfield: {},
+	GoValidMarkerGtefield: {},
+	GoValidMarkerGtfield: {},
+	GoValidMarkerImmutable: {},
+	GoValidMarkerImmutable_once_set: {},
 	GoValidMarkerIpv4: {},
 	GoValidMarkerIpv6: {},
 	GoValidMarkerIscolour: {},
@@ -191,8 +280,14 @@ var GoValidMarkers = map[string]struct{}{
 	GoValidMarkerLongitude: {},
 	GoValidMarkerLowercase: {},
 	GoValidMarkerLt: {},
+	GoValidMarkerLtcsfield: {},
 	GoValidMarkerLte: {},
+	GoValidMarkerLtecsfield: {},
+	GoValidMarkerLtefield: {},
+	GoValidMarkerLtfield: {},
 	GoValidMarkerMaxduration: {},
+	GoValidMarkerMaxfiles: {},
+	GoValidMarkerMaxfilesize: {},
 	GoValidMarkerMaxitems: {},
 	GoValidMarkerMaxlength: {},
 	GoValidMarkerMin: {},
@@ -200,8 +295,12 @@ var GoValidMarkers = map[string]struct{}{
 	GoValidMarkerMinitems: {},
 	GoValidMarkerMinlength: {},
 	GoValidMarkerNe: {},
+	GoValidMarkerNecsfield: {},
+	GoValidMarkerNefield: {},
+	GoValidMarkerNot_null: {},
 	GoValidMarkerNumber: {},
 	GoValidMarkerNumeric: {},
+	GoValidMarkerOne_of_required: {},
 	GoValidMarkerOneof: {},
 	GoValidMarkerRequired: {},
 	GoValidMarkerRequired_if: {},
@@ -214,4 +313,5 @@ var GoValidMarkers = map[string]struct{}{
 	GoValidMarkerUri: {},
 	GoValidMarkerUrl: {},
 	GoValidMarkerUuid: {},
+	GoValidMarkerWhen: {},
 }
@@ -8,8 +8,10 @@ import (
 // All returns all built-in validator initializers.
 func All() []registry.ValidatorInitializer {
 	return []registry.ValidatorInitializer{
+		All_or_none_ofInitializer{},
 		AlphaInitializer{},
 		AlphanumInitializer{},
+		At_most_one_ofInitializer{},
 		BooleanInitializer{},
 		CelInitializer{},
 		ContainsanyInitializer{},
@@ -17,6 +19,9 @@ func All() []registry.ValidatorInitializer {
 		EmailInitializer{},
 		EnumInitializer{},
 		EqInitializer{},
+		EqcsfieldInitializer{},
+		EqfieldInitializer{},
+		Exactly_one_ofInitializer{},
 		Excluded_ifInitializer{},
 		Excluded_unlessInitializer{},
 		Excluded_withInitializer{},
@@ -25,9 +30,18 @@ func All() []registry.ValidatorInitializer {
 		Excluded_without_allInitializer{},
 		ExcludesInitializer{},
 		ExcludesallInitializer{},
+		ExprInitializer{},
+		FileextInitializer{},
+		FiletypeInitializer{},
 		FqdnInitializer{},
 		GtInitializer{},
+		GtcsfieldInitializer{},
 		GteInitializer{},
+		GtecsfieldInitializer{},
+		GtefieldInitializer{},
+		GtfieldInitializer{},
+		ImmutableInitializer{},
+		Immutable_once_setInitializer{},
 		Ipv4Initializer{},
 		Ipv6Initializer{},
 		IscolourInitializer{},
@@ -37,8 +51,14 @@ func All() []registry.ValidatorInitializer {
 		LongitudeInitializer{},
 		LowercaseInitializer{},
 		LtInitializer{},
+		LtcsfieldInitializer{},
 		LteInitializer{},
+		LtecsfieldInitializer{},
+		LtefieldInitializer{},
+		LtfieldInitializer{},
 		MaxdurationInitializer{},
+		MaxfilesInitializer{},
+		MaxfilesizeInitializer{},
 		MaxitemsInitializer{},
 		MaxlengthInitializer{},
 		MinInitializer{},
@@ -46,8 +66,12 @@ func All() []registry.ValidatorInitializer {
 		MinitemsInitializer{},
 		MinlengthInitializer{},
 		NeInitializer{},
+		NecsfieldInitializer{},
+		NefieldInitializer{},
+		Not_nullInitializer{},
 		NumberInitializer{},
 		NumericInitializer{},
+		One_of_requiredInitializer{},
 		OneofInitializer{},
 		RequiredInitializer{},
 		Required_ifInitializer{},
@@ -60,5 +84,6 @@ func All() []registry.ValidatorInitializer {
 		UriInitializer{},
 		UrlInitializer{},
 		UuidInitializer{},
+		WhenInitializer{},
 	}
 }
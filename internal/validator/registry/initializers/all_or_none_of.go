@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// All_or_none_ofInitializer implements ValidatorInitializer for the all_or_none_of validator.
+type All_or_none_ofInitializer struct{}
+
+// Marker returns the marker identifier for the all_or_none_of validator.
+func (a All_or_none_ofInitializer) Marker() string {
+	return markers.GoValidMarkerAll_or_none_of
+}
+
+// Init initializes the all_or_none_of validator factory.
+func (a All_or_none_ofInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateAllOrNoneOf
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// At_most_one_ofInitializer implements ValidatorInitializer for the at_most_one_of validator.
+type At_most_one_ofInitializer struct{}
+
+// Marker returns the marker identifier for the at_most_one_of validator.
+func (a At_most_one_ofInitializer) Marker() string {
+	return markers.GoValidMarkerAt_most_one_of
+}
+
+// Init initializes the at_most_one_of validator factory.
+func (a At_most_one_ofInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateAtMostOneOf
+}
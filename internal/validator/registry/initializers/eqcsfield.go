@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// EqcsfieldInitializer implements ValidatorInitializer for the eqcsfield validator.
+type EqcsfieldInitializer struct{}
+
+// Marker returns the marker identifier for the eqcsfield validator.
+func (e EqcsfieldInitializer) Marker() string {
+	return markers.GoValidMarkerEqcsfield
+}
+
+// Init initializes the eqcsfield validator factory.
+func (e EqcsfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateEqCSField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// EqfieldInitializer implements ValidatorInitializer for the eqfield validator.
+type EqfieldInitializer struct{}
+
+// Marker returns the marker identifier for the eqfield validator.
+func (e EqfieldInitializer) Marker() string {
+	return markers.GoValidMarkerEqfield
+}
+
+// Init initializes the eqfield validator factory.
+func (e EqfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateEqField
+}
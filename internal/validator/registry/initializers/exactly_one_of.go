@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// Exactly_one_ofInitializer implements ValidatorInitializer for the exactly_one_of validator.
+type Exactly_one_ofInitializer struct{}
+
+// Marker returns the marker identifier for the exactly_one_of validator.
+func (e Exactly_one_ofInitializer) Marker() string {
+	return markers.GoValidMarkerExactly_one_of
+}
+
+// Init initializes the exactly_one_of validator factory.
+func (e Exactly_one_ofInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateExactlyOneOf
+}
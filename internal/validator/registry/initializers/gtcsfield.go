@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// GtcsfieldInitializer implements ValidatorInitializer for the gtcsfield validator.
+type GtcsfieldInitializer struct{}
+
+// Marker returns the marker identifier for the gtcsfield validator.
+func (g GtcsfieldInitializer) Marker() string {
+	return markers.GoValidMarkerGtcsfield
+}
+
+// Init initializes the gtcsfield validator factory.
+func (g GtcsfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateGtCSField
+}
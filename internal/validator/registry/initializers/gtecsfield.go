@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// GtecsfieldInitializer implements ValidatorInitializer for the gtecsfield validator.
+type GtecsfieldInitializer struct{}
+
+// Marker returns the marker identifier for the gtecsfield validator.
+func (g GtecsfieldInitializer) Marker() string {
+	return markers.GoValidMarkerGtecsfield
+}
+
+// Init initializes the gtecsfield validator factory.
+func (g GtecsfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateGteCSField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// GtefieldInitializer implements ValidatorInitializer for the gtefield validator.
+type GtefieldInitializer struct{}
+
+// Marker returns the marker identifier for the gtefield validator.
+func (g GtefieldInitializer) Marker() string {
+	return markers.GoValidMarkerGtefield
+}
+
+// Init initializes the gtefield validator factory.
+func (g GtefieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateGteField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// GtfieldInitializer implements ValidatorInitializer for the gtfield validator.
+type GtfieldInitializer struct{}
+
+// Marker returns the marker identifier for the gtfield validator.
+func (g GtfieldInitializer) Marker() string {
+	return markers.GoValidMarkerGtfield
+}
+
+// Init initializes the gtfield validator factory.
+func (g GtfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateGtField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// ImmutableInitializer implements ValidatorInitializer for the immutable validator.
+type ImmutableInitializer struct{}
+
+// Marker returns the marker identifier for the immutable validator.
+func (i ImmutableInitializer) Marker() string {
+	return markers.GoValidMarkerImmutable
+}
+
+// Init initializes the immutable validator factory.
+func (i ImmutableInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateImmutable
+}
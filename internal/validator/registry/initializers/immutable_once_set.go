@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// Immutable_once_setInitializer implements ValidatorInitializer for the immutable_once_set validator.
+type Immutable_once_setInitializer struct{}
+
+// Marker returns the marker identifier for the immutable_once_set validator.
+func (i Immutable_once_setInitializer) Marker() string {
+	return markers.GoValidMarkerImmutable_once_set
+}
+
+// Init initializes the immutable_once_set validator factory.
+func (i Immutable_once_setInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateImmutableOnceSet
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// LtcsfieldInitializer implements ValidatorInitializer for the ltcsfield validator.
+type LtcsfieldInitializer struct{}
+
+// Marker returns the marker identifier for the ltcsfield validator.
+func (l LtcsfieldInitializer) Marker() string {
+	return markers.GoValidMarkerLtcsfield
+}
+
+// Init initializes the ltcsfield validator factory.
+func (l LtcsfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateLtCSField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// LtecsfieldInitializer implements ValidatorInitializer for the ltecsfield validator.
+type LtecsfieldInitializer struct{}
+
+// Marker returns the marker identifier for the ltecsfield validator.
+func (l LtecsfieldInitializer) Marker() string {
+	return markers.GoValidMarkerLtecsfield
+}
+
+// Init initializes the ltecsfield validator factory.
+func (l LtecsfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateLteCSField
+}
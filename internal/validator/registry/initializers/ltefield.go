@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// LtefieldInitializer implements ValidatorInitializer for the ltefield validator.
+type LtefieldInitializer struct{}
+
+// Marker returns the marker identifier for the ltefield validator.
+func (l LtefieldInitializer) Marker() string {
+	return markers.GoValidMarkerLtefield
+}
+
+// Init initializes the ltefield validator factory.
+func (l LtefieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateLteField
+}
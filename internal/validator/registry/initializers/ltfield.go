@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// LtfieldInitializer implements ValidatorInitializer for the ltfield validator.
+type LtfieldInitializer struct{}
+
+// Marker returns the marker identifier for the ltfield validator.
+func (l LtfieldInitializer) Marker() string {
+	return markers.GoValidMarkerLtfield
+}
+
+// Init initializes the ltfield validator factory.
+func (l LtfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateLtField
+}
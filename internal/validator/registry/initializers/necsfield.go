@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// NecsfieldInitializer implements ValidatorInitializer for the necsfield validator.
+type NecsfieldInitializer struct{}
+
+// Marker returns the marker identifier for the necsfield validator.
+func (n NecsfieldInitializer) Marker() string {
+	return markers.GoValidMarkerNecsfield
+}
+
+// Init initializes the necsfield validator factory.
+func (n NecsfieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateNeCSField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// NefieldInitializer implements ValidatorInitializer for the nefield validator.
+type NefieldInitializer struct{}
+
+// Marker returns the marker identifier for the nefield validator.
+func (n NefieldInitializer) Marker() string {
+	return markers.GoValidMarkerNefield
+}
+
+// Init initializes the nefield validator factory.
+func (n NefieldInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateNeField
+}
@@ -0,0 +1,21 @@
+// Code generated by generate-validators; DO NOT EDIT.
+package initializers
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/rules"
+)
+
+// One_of_requiredInitializer implements ValidatorInitializer for the one_of_required validator.
+type One_of_requiredInitializer struct{}
+
+// Marker returns the marker identifier for the one_of_required validator.
+func (o One_of_requiredInitializer) Marker() string {
+	return markers.GoValidMarkerOne_of_required
+}
+
+// Init initializes the one_of_required validator factory.
+func (o One_of_requiredInitializer) Init() registry.ValidatorFactory {
+	return rules.ValidateOneOfRequired
+}
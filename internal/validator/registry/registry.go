@@ -4,6 +4,8 @@ package registry
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
+	"go/types"
 
 	"github.com/gostaticanalysis/codegen"
 
@@ -27,8 +29,25 @@ type ValidatorInput struct {
 	StructName  string
 	RuleName    string
 	ParentPath  string
+	// Presence is the name of the govalid.Presence field of a struct marked with +govalid:presence,
+	// empty for other structs and for fields of nested structs.
+	Presence string
+	// Nullable reports whether the field is marked with +govalid:nullable.
+	Nullable bool
+	// DeclaredBy is the named type declaring the marker when the field inherits it from its type,
+	// possibly from another package, nil for the markers of the field and of its struct.
+	DeclaredBy *types.TypeName
+	// Occurrence is the number of markers of the same rule declared before this one, distinguishing
+	// the error variables of the struct-level rules repeated on a type.
+	Occurrence int
+	// Report reports a diagnostic for a rule that cannot apply, such as a reference to a field
+	// that does not exist, making the generation fail.
+	Report ReportFunc
 }
 
+// ReportFunc reports a diagnostic at a position of the analyzed source.
+type ReportFunc func(pos token.Pos, format string, args ...any)
+
 // ValidatorFactory is a function that creates a validator instance.
 type ValidatorFactory func(input ValidatorInput) validator.Validator
 
@@ -0,0 +1,46 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type all_or_none_ofValidator struct {
+	*fieldGroup
+}
+
+var _ validator.DependentValidator = (*all_or_none_ofValidator)(nil)
+
+const all_or_none_ofKey = "%s-all_or_none_of"
+
+func (v *all_or_none_ofValidator) Validate() string {
+	return "(" + strings.Join(v.checks, " || ") + ") && !(" + strings.Join(v.checks, " && ") + ")"
+}
+
+func (v *all_or_none_ofValidator) Err() string {
+	return v.err(all_or_none_ofKey, v.ErrVariable(), "either all or none of [@FIELDS] must be set")
+}
+
+func (v *all_or_none_ofValidator) ErrVariable() string {
+	return v.errVariable("AllOrNoneOf")
+}
+
+func (v *all_or_none_ofValidator) Imports() []string {
+	return v.fieldGroup.Imports()
+}
+
+// ValidateAllOrNoneOf creates a new all_or_none_ofValidator for the struct type declaring the marker,
+// e.g., +govalid:all_or_none_of=CardToken BankAccount Wallet.
+// Either all of the fields or none of them must be set.
+func ValidateAllOrNoneOf(input registry.ValidatorInput) validator.Validator {
+	group := newFieldGroup(input, markers.GoValidMarkerAll_or_none_of)
+	if group == nil {
+		return nil
+	}
+
+	return &all_or_none_ofValidator{fieldGroup: group}
+}
@@ -26,7 +26,7 @@ const alphaKey = "%s-alpha"
 
 func (v *alphaValidator) Validate() string {
 	// Use external helper function for better maintainability
-	return fmt.Sprintf(`!validationhelper.IsValidAlpha(t.%s)`, v.FieldName())
+	return fmt.Sprintf(`!validationhelper.IsValidAlpha(%s)`, stringField(v.pass, v.field))
 }
 
 func (v *alphaValidator) FieldName() string {
@@ -26,8 +26,7 @@ var _ validator.Validator = (*alphanumValidator)(nil)
 const alphanumKey = "%s-alphanum"
 
 func (a *alphanumValidator) Validate() string {
-	fieldName := a.FieldName()
-	return fmt.Sprintf("!validationhelper.IsAlphanum(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsAlphanum(%s)", stringField(a.pass, a.field))
 }
 
 func (a *alphanumValidator) FieldName() string {
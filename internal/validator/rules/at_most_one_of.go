@@ -0,0 +1,44 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type at_most_one_ofValidator struct {
+	*fieldGroup
+}
+
+var _ validator.DependentValidator = (*at_most_one_ofValidator)(nil)
+
+const at_most_one_ofKey = "%s-at_most_one_of"
+
+func (v *at_most_one_ofValidator) Validate() string {
+	return v.count() + " > 1"
+}
+
+func (v *at_most_one_ofValidator) Err() string {
+	return v.err(at_most_one_ofKey, v.ErrVariable(), "at most one of [@FIELDS] may be set")
+}
+
+func (v *at_most_one_ofValidator) ErrVariable() string {
+	return v.errVariable("AtMostOneOf")
+}
+
+func (v *at_most_one_ofValidator) Imports() []string {
+	return append([]string{"github.com/templatedop/govalid/validation/validationhelper"}, v.fieldGroup.Imports()...)
+}
+
+// ValidateAtMostOneOf creates a new at_most_one_ofValidator for the struct type declaring the marker,
+// e.g., +govalid:at_most_one_of=CardToken BankAccount Wallet.
+// At most one of the fields may be set, making them mutually exclusive.
+func ValidateAtMostOneOf(input registry.ValidatorInput) validator.Validator {
+	group := newFieldGroup(input, markers.GoValidMarkerAt_most_one_of)
+	if group == nil {
+		return nil
+	}
+
+	return &at_most_one_ofValidator{fieldGroup: group}
+}
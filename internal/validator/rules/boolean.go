@@ -26,9 +26,8 @@ var _ validator.Validator = (*booleanValidator)(nil)
 const booleanKey = "%s-boolean"
 
 func (b *booleanValidator) Validate() string {
-	fieldName := b.FieldName()
 	// Use external helper function for boolean validation
-	return fmt.Sprintf("!validationhelper.IsValidBoolean(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidBoolean(%s)", stringField(b.pass, b.field))
 }
 
 func (b *booleanValidator) FieldName() string {
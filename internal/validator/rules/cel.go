@@ -4,10 +4,15 @@ package rules
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
+	"go/types"
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
 	"github.com/gostaticanalysis/codegen"
 	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 
@@ -16,47 +21,90 @@ import (
 	"github.com/templatedop/govalid/internal/validator/registry"
 )
 
+// celValidator evaluates a CEL expression against a field, or against the whole struct
+// when field is nil, for struct-level rules declared on the type.
 type celValidator struct {
-	pass       *codegen.Pass
-	field      *ast.Field
+	pass  *codegen.Pass
+	field *ast.Field
+	// holder is the type of the struct holding the field, resolved from field, or from structName for
+	// struct-level rules, when nil.
+	holder     types.Type
 	expression string
 	structName string
 	ruleName   string
 	parentPath string
+	transition bool
+	// suffix distinguishes the error variables of the struct-level rules repeated on a type.
+	suffix string
+	// condition is the Go expression converted from the CEL expression.
+	condition string
+
+	// The state of the conversion: the structs of the CEL environment, the Go types of value and this,
+	// the CEL types of the checked expression by node, and the Go types of the nodes referencing fields
+	// and of the variables of comprehensions.
+	structs   *celStructs
+	valueType types.Type
+	thisType  types.Type
+	checked   map[int64]*celtypes.Type
+	goTypes   map[int64]types.Type
+	locals    map[string]types.Type
+	// functions are the Go functions of the custom CEL functions declared in the environment, by name.
+	functions map[string]*types.Func
+	// imports are the packages used by the converted expression.
+	imports []string
+	// err is the first function or expression of the CEL expression without a Go conversion.
+	err error
 }
 
-var _ validator.Validator = (*celValidator)(nil)
+var (
+	_ validator.DependentValidator  = (*celValidator)(nil)
+	_ validator.TransitionValidator = (*celValidator)(nil)
+)
+
+var (
+	// celFieldReference matches the fields of the struct referenced by a CEL expression, e.g., this.Age.
+	celFieldReference = regexp.MustCompile(`\bthis\.(\w+)`)
+	// celSelfReference matches the fields referenced through the value of a struct-level rule, e.g., self.End.
+	celSelfReference = regexp.MustCompile(`\b(?:self|value)\.(\w+)`)
+	// celOldSelfReference matches the references to the previous value of the field in transition rules.
+	celOldSelfReference = regexp.MustCompile(`\boldSelf\b`)
+)
 
 const (
-	celKey            = "%s-cel"
-	trueFallback      = "true"
-	placeholderList   = "[]interface{}{}"
-	placeholderStruct = "struct{}{}"
-	ternaryOperator   = "_?_:_"
+	celKey          = "%s-cel"
+	trueFallback    = "true"
+	ternaryOperator = "_?_:_"
 )
 
 func (c *celValidator) Validate() string {
-	fieldName := c.FieldName()
-	// Convert CEL expression to Go expression at generation time
-	goExpr, err := c.convertCELToGo(c.expression, fieldName)
-	if err != nil {
-		// Fallback to comment if conversion fails
-		return fmt.Sprintf("true /* CEL conversion failed: %v */", err)
-	}
-	// Return the converted Go expression wrapped in negation for validation
-	return fmt.Sprintf("!(%s)", goExpr)
+	// The expression is converted to Go at generation time
+	return fmt.Sprintf("!(%s)", c.condition)
 }
 
 func (c *celValidator) FieldName() string {
+	if c.field == nil {
+		return ""
+	}
+
 	return c.field.Names[0].Name
 }
 
+// valueOf returns the Go expression of the value of the rule within the struct variable v:
+// the field, or the struct itself for struct-level rules.
+func (c *celValidator) valueOf(v string) string {
+	if c.field == nil {
+		return v
+	}
+
+	return fmt.Sprintf("%s.%s", v, c.FieldName())
+}
+
 func (c *celValidator) FieldPath() validator.FieldPath {
 	return validator.NewFieldPath(c.structName, c.parentPath, c.FieldName())
 }
 
 func (c *celValidator) Err() string {
-	key := fmt.Sprintf(celKey, c.FieldPath().CleanedPath())
+	key := fmt.Sprintf(celKey, c.FieldPath().CleanedPath()+c.suffix)
 
 	if validator.GeneratorMemory[key] {
 		return ""
@@ -73,7 +121,12 @@ func (c *celValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the CEL expression evaluation fails.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] failed CEL validation: [@EXPRESSION]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] failed CEL validation: [@EXPRESSION]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@EXPRESSION]"}
+	`
+
+	const structErrTemplate = `
+		// [@ERRVARIABLE] is the error returned when the struct-level CEL expression evaluation fails.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "[@PATH] failed CEL validation: [@EXPRESSION]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@EXPRESSION]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sCELValidation", c.structName, c.FieldName())
@@ -88,6 +141,10 @@ func (c *celValidator) Err() string {
 		"[@TYPE]", c.ruleName,
 	)
 
+	if c.field == nil {
+		return replacer.Replace(structErrTemplate)
+	}
+
 	if currentErrVarName != legacyErrVarName {
 		return replacer.Replace(deprecationNoticeTemplate + errTemplate)
 	}
@@ -96,64 +153,45 @@ func (c *celValidator) Err() string {
 }
 
 func (c *celValidator) ErrVariable() string {
-	return strings.ReplaceAll("Err[@PATH]CELValidation", "[@PATH]", c.FieldPath().CleanedPath())
+	return "Err" + c.FieldPath().CleanedPath() + "CEL" + c.suffix + "Validation"
 }
 
-func (c *celValidator) Imports() []string {
-	imports := []string{}
-
-	// Add imports based on the CEL expression content
-	if c.needsStringsImport() {
-		imports = append(imports, "strings")
-	}
-
-	if strings.Contains(c.expression, "matches(") {
-		imports = append(imports, "regexp")
-	}
-
-	if c.needsStrconvImport() {
-		imports = append(imports, "strconv")
-	}
+// DependsOn implements validator.DependentValidator, returning the fields referenced through this,
+// and for struct-level rules through self and value.
+func (c *celValidator) DependsOn() []string {
+	var fields []string
 
-	if c.needsFmtImport() {
-		imports = append(imports, "fmt")
+	matches := celFieldReference.FindAllStringSubmatch(c.expression, -1)
+	if c.field == nil {
+		matches = append(matches, celSelfReference.FindAllStringSubmatch(c.expression, -1)...)
 	}
 
-	if c.needsTimeImport() {
-		imports = append(imports, "time")
-	}
-
-	// Add slices import for optimized contains operations
-	if strings.Contains(c.expression, " in ") {
-		imports = append(imports, "slices")
+	for _, match := range matches {
+		if match[1] != c.FieldName() && !slices.Contains(fields, match[1]) {
+			fields = append(fields, match[1])
+		}
 	}
 
-	return imports
+	return fields
 }
 
-func (c *celValidator) needsStringsImport() bool {
-	return strings.Contains(c.expression, "contains(") ||
-		strings.Contains(c.expression, "startsWith(") ||
-		strings.Contains(c.expression, "endsWith(")
+// IsTransition implements validator.TransitionValidator, reporting whether the expression
+// references oldSelf, the value of the field before an update.
+func (c *celValidator) IsTransition() bool {
+	return c.transition
 }
 
-func (c *celValidator) needsStrconvImport() bool {
-	return strings.Contains(c.expression, "int(") ||
-		strings.Contains(c.expression, "double(")
-}
-
-func (c *celValidator) needsFmtImport() bool {
-	return strings.Contains(c.expression, "string(") ||
-		strings.Contains(c.expression, "double(")
-}
-
-func (c *celValidator) needsTimeImport() bool {
-	return strings.Contains(c.expression, "timestamp(") ||
-		strings.Contains(c.expression, "duration(")
+// Imports returns the packages used by the converted expression, collected during the conversion.
+func (c *celValidator) Imports() []string {
+	return c.imports
 }
 
 // ValidateCEL creates a new celValidator for fields with CEL marker.
 // This validator supports all field types since CEL can handle various data types.
+// Expressions referencing oldSelf are transition rules, only evaluated on updates.
+// Without a field, the expression is a struct-level rule where self is the struct.
+// The expression is type-checked against the Go types of the field and the struct, see convertCELToGo,
+// and reported as a diagnostic when it does not compile, returning nil.
 func ValidateCEL(input registry.ValidatorInput) validator.Validator {
 	celExpression, ok := input.Expressions[markers.GoValidMarkerCel]
 	if !ok {
@@ -165,29 +203,115 @@ func ValidateCEL(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
-	return &celValidator{
+	// The previous value of the elements of collections cannot be matched
+	if celOldSelfReference.MatchString(celExpression) && strings.Contains(input.ParentPath, "[i]") {
+		if input.Field != nil {
+			input.Report(input.Field.Pos(), "%s: oldSelf is not supported on dive elements, as their previous value cannot be matched", input.RuleName)
+		}
+
+		return nil
+	}
+
+	c := &celValidator{
 		pass:       input.Pass,
 		field:      input.Field,
 		expression: celExpression,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
+		transition: celOldSelfReference.MatchString(celExpression),
+		suffix:     occurrenceSuffix(input.Occurrence),
+	}
+
+	condition, err := c.convertCELToGo(celExpression, c.FieldName())
+	if err != nil {
+		input.Report(c.pos(), "%s: %v", input.RuleName, err)
+
+		return nil
+	}
+
+	c.condition = condition
+
+	return c
+}
+
+// pos returns the position of the rule: the field, or the type for struct-level rules.
+func (c *celValidator) pos() token.Pos {
+	if c.field != nil {
+		return c.field.Pos()
+	}
+
+	if obj := c.pass.Pkg.Scope().Lookup(c.structName); obj != nil {
+		return obj.Pos()
 	}
+
+	return token.NoPos
+}
+
+// operandTypes returns the Go types of value, the field or the struct of struct-level rules, and of
+// this, the struct holding the field. Types that cannot be resolved are nil, and dynamic in CEL.
+func (c *celValidator) operandTypes() (types.Type, types.Type) {
+	if c.pass == nil {
+		return nil, nil
+	}
+
+	holder := c.holder
+	if holder == nil {
+		if c.field != nil {
+			holder = enclosingStruct(c.pass, c.field)
+		} else if obj := c.pass.Pkg.Scope().Lookup(c.structName); obj != nil {
+			holder = obj.Type()
+		}
+	}
+
+	if c.field == nil {
+		return holder, holder
+	}
+
+	return c.pass.TypesInfo.TypeOf(c.field.Type), holder
 }
 
 // convertCELToGo converts a CEL expression to equivalent Go code.
+// The environment declares value, self and oldSelf with the CEL type of the field, and this with the
+// type of the struct holding it, whose fields are selected by Go or JSON name, so the expression is
+// type-checked. Numbers are compared across types, as CEL does. The strings, lists, math and sets
+// extensions of cel-go and the optional types are available, and functions without a Go conversion,
+// such as format, are reported. The Go functions marked with +govalid:celfunc are custom CEL functions,
+// converted to calls of the Go functions.
 func (c *celValidator) convertCELToGo(celExpr, fieldName string) (string, error) {
 	// Pre-validate that this is a standard CEL expression
 	if err := c.validateStandardCEL(celExpr); err != nil {
 		return "", fmt.Errorf("non-standard CEL expression: %w", err)
 	}
 
+	structs, err := newCELStructs()
+	if err != nil {
+		return "", fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	c.structs = structs
+	c.valueType, c.thisType = c.operandTypes()
+
+	functions, err := c.declareCustomFunctions(celExpr)
+	if err != nil {
+		return "", err
+	}
+
 	// Create a CEL environment to parse the expression
-	env, err := cel.NewEnv(
+	env, err := cel.NewEnv(append([]cel.EnvOption{
+		cel.CustomTypeProvider(structs),
 		cel.StdLib(),
-		cel.Variable("value", cel.DynType),
-		cel.Variable("this", cel.DynType),
-	)
+		cel.CrossTypeNumericComparisons(true),
+		cel.OptionalTypes(),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Math(),
+		ext.Sets(),
+		cel.Variable("value", structs.celType(c.valueType)),
+		cel.Variable("this", structs.celType(c.thisType)),
+		cel.Variable("self", structs.celType(c.valueType)),
+		cel.Variable("oldSelf", structs.celType(c.valueType)),
+	}, functions...)...)
 	if err != nil {
 		return "", fmt.Errorf("failed to create CEL environment: %w", err)
 	}
@@ -198,101 +322,354 @@ func (c *celValidator) convertCELToGo(celExpr, fieldName string) (string, error)
 		return "", fmt.Errorf("failed to compile CEL expression: %w", issues.Err())
 	}
 
+	c.checked = ast.NativeRep().TypeMap()
+	c.goTypes = map[int64]types.Type{}
+	c.locals = map[string]types.Type{}
+
 	// Convert CEL AST to Go expression string
 	// Use the parsed AST directly to avoid deprecated methods
 	//nolint:staticcheck // ast.Expr() is deprecated but still functional
 	goExpr := c.convertASTToGo(ast.Expr(), fieldName)
+	if c.err != nil {
+		return "", c.err
+	}
 
 	return goExpr, nil
 }
 
+// use records that the converted expression uses the packages pkgs.
+func (c *celValidator) use(pkgs ...string) {
+	c.imports = appendImports(c.imports, pkgs...)
+}
+
+// unsupported records that what, a function or an expression of the CEL expression, has no Go
+// conversion, failing the conversion, and returns a placeholder for it.
+func (c *celValidator) unsupported(what string) string {
+	if c.err == nil {
+		c.err = fmt.Errorf("%s is not supported by the Go conversion", what)
+	}
+
+	return trueFallback
+}
+
 // convertASTToGo recursively converts CEL AST nodes to Go expression strings.
 func (c *celValidator) convertASTToGo(expr *exprpb.Expr, fieldName string) string {
+	if c.isOptional(expr) {
+		// Optional values are converted where they are consumed, see convertOptional
+		return c.unsupported("optional value")
+	}
+
 	switch expr.ExprKind.(type) {
 	case *exprpb.Expr_IdentExpr:
+		c.goTypes[expr.GetId()] = c.identType(expr.GetIdentExpr().GetName())
+
 		return c.convertIdentExpr(expr.GetIdentExpr(), fieldName)
 	case *exprpb.Expr_SelectExpr:
-		return c.convertSelectExpr(expr.GetSelectExpr(), fieldName)
+		return c.convertSelectExpr(expr, fieldName)
 	case *exprpb.Expr_CallExpr:
-		return c.convertCallToGo(expr.GetCallExpr(), fieldName)
+		return c.convertCallToGo(expr, fieldName)
 	case *exprpb.Expr_ConstExpr:
 		return c.convertConstToGo(expr.GetConstExpr())
 	case *exprpb.Expr_ListExpr:
-		return c.convertListExpr(expr.GetListExpr(), fieldName)
+		return c.convertListExpr(expr, nil, fieldName)
 	case *exprpb.Expr_StructExpr:
-		return placeholderStruct // placeholder
+		return c.convertMapExpr(expr, fieldName)
 	case *exprpb.Expr_ComprehensionExpr:
-		return c.convertComprehensionExpr(expr.GetComprehensionExpr(), fieldName)
+		return c.convertComprehensionExpr(expr, fieldName)
 	default:
-		return trueFallback // fallback
+		return c.unsupported("expression")
 	}
 }
 
 // convertIdentExpr converts identifier expressions.
 func (c *celValidator) convertIdentExpr(ident *exprpb.Expr_Ident, fieldName string) string {
 	switch ident.Name {
-	case "value":
-		return fmt.Sprintf("t.%s", fieldName)
+	case "value", "self":
+		return c.valueOf("t")
 	case "this":
 		return "t"
+	case "oldSelf":
+		return c.valueOf("old")
 	default:
 		return ident.Name
 	}
 }
 
-// convertSelectExpr converts select expressions (field access).
-func (c *celValidator) convertSelectExpr(selectExpr *exprpb.Expr_Select, fieldName string) string {
+// identType returns the Go type of the identifier of a variable, or nil.
+func (c *celValidator) identType(name string) types.Type {
+	switch name {
+	case "value", "self", "oldSelf":
+		return c.valueType
+	case "this":
+		return c.thisType
+	default:
+		return c.locals[name]
+	}
+}
+
+// convertSelectExpr converts select expressions (field access), and the presence tests of has().
+// Fields of the declared structs may be selected by their JSON name, converted to their Go name,
+// while the fields of maps are their keys.
+func (c *celValidator) convertSelectExpr(expr *exprpb.Expr, fieldName string) string {
+	selectExpr := expr.GetSelectExpr()
 	operand := c.convertASTToGo(selectExpr.Operand, fieldName)
+	name := selectExpr.Field
 
-	if operand == "t" {
-		return fmt.Sprintf("t.%s", selectExpr.Field)
+	if c.celKind(selectExpr.Operand) == celtypes.MapKind {
+		if selectExpr.TestOnly {
+			return fmt.Sprintf("func() bool { _, ok := %s[%q]; return ok }()", operand, name)
+		}
+
+		c.goTypes[expr.GetId()] = c.elemType(selectExpr.Operand)
+
+		return fmt.Sprintf("%s[%q]", operand, name)
 	}
 
-	return fmt.Sprintf("%s.%s", operand, selectExpr.Field)
+	field, ok := c.structs.field(c.checked[selectExpr.Operand.GetId()], name)
+	if ok {
+		name = field.name
+		c.goTypes[expr.GetId()] = field.typ
+	}
+
+	selected := fmt.Sprintf("%s.%s", operand, name)
+
+	if selectExpr.TestOnly {
+		// The fields of structs are present when they are set, as in proto3
+		if !ok {
+			return c.unsupported("has() of " + name)
+		}
+
+		return c.isSet(selected, field.typ)
+	}
+
+	return selected
+}
+
+// isSet returns the Go expression testing whether the value s of type typ is set, not its zero value.
+func (c *celValidator) isSet(s string, typ types.Type) string {
+	if isTimeType(typ) {
+		return fmt.Sprintf("!%s.IsZero()", s)
+	}
+
+	check, imports := isSet(s, typ)
+	c.use(imports...)
+
+	return check
+}
+
+// celKind returns the CEL kind of the checked expression.
+func (c *celValidator) celKind(expr *exprpb.Expr) celtypes.Kind {
+	if typ, ok := c.checked[expr.GetId()]; ok {
+		return typ.Kind()
+	}
+
+	return celtypes.DynKind
+}
+
+// goType returns the Go type of the converted expression: the type of the field or variable it
+// references, or of the Go function it is converted to, or else the Go type of its CEL type.
+func (c *celValidator) goType(expr *exprpb.Expr) types.Type {
+	if typ := c.goTypes[expr.GetId()]; typ != nil {
+		return typ
+	}
+
+	return c.goTypeOfCEL(c.checked[expr.GetId()])
+}
+
+// celTimePackage is the time package, declaring the Go types of timestamps and durations.
+var celTimePackage = types.NewPackage("time", "time")
+
+// anyType is the Go type of dynamic CEL values.
+var anyType = types.Universe.Lookup("any").Type()
+
+// goTypeOfCEL returns the Go type of the values of the CEL type typ, int64 for integers, or the Go type
+// of the declared structs.
+func (c *celValidator) goTypeOfCEL(typ *celtypes.Type) types.Type {
+	if typ == nil {
+		return anyType
+	}
+
+	switch typ.Kind() {
+	case celtypes.BoolKind:
+		return types.Typ[types.Bool]
+	case celtypes.IntKind:
+		return types.Typ[types.Int64]
+	case celtypes.UintKind:
+		return types.Typ[types.Uint64]
+	case celtypes.DoubleKind:
+		return types.Typ[types.Float64]
+	case celtypes.StringKind:
+		return types.Typ[types.String]
+	case celtypes.BytesKind:
+		return types.NewSlice(types.Typ[types.Byte])
+	case celtypes.TimestampKind, celtypes.DurationKind:
+		name := "Time"
+		if typ.Kind() == celtypes.DurationKind {
+			name = "Duration"
+		}
+
+		return types.NewNamed(types.NewTypeName(token.NoPos, celTimePackage, name, nil), types.NewStruct(nil, nil), nil)
+	case celtypes.ListKind:
+		return types.NewSlice(c.goTypeOfCEL(typ.Parameters()[0]))
+	case celtypes.MapKind:
+		return types.NewMap(c.goTypeOfCEL(typ.Parameters()[0]), c.goTypeOfCEL(typ.Parameters()[1]))
+	case celtypes.StructKind:
+		for goType, name := range c.structs.names {
+			if name == typ.TypeName() {
+				return goType
+			}
+		}
+	}
+
+	return anyType
+}
+
+// elemType returns the Go type of the elements of the list, or of the values of the map, expr.
+func (c *celValidator) elemType(expr *exprpb.Expr) types.Type {
+	switch t := c.goType(expr).Underlying().(type) {
+	case *types.Slice:
+		return t.Elem()
+	case *types.Array:
+		return t.Elem()
+	case *types.Map:
+		return t.Elem()
+	case *types.Pointer:
+		if array, ok := t.Elem().Underlying().(*types.Array); ok {
+			return array.Elem()
+		}
+	}
+
+	return anyType
+}
+
+// typeString returns the Go type typ as written in the generated code, importing its package.
+func (c *celValidator) typeString(typ types.Type) string {
+	return types.TypeString(typ, func(pkg *types.Package) string {
+		if c.pass != nil && pkg.Path() == c.pass.Pkg.Path() {
+			return ""
+		}
+
+		c.use(pkg.Path())
+
+		return pkg.Name()
+	})
+}
+
+// convertAs converts expr to a Go value of type typ, converting the values of other Go types of the
+// same CEL type, such as int fields to int64. Constants are left to the implicit conversions of Go.
+func (c *celValidator) convertAs(expr *exprpb.Expr, typ types.Type, fieldName string) string {
+	s := c.convertASTToGo(expr, fieldName)
+	if expr.GetConstExpr() != nil {
+		return s
+	}
+
+	if from := c.goType(expr); !types.Identical(from, typ) && types.ConvertibleTo(from, typ) {
+		return fmt.Sprintf("%s(%s)", c.typeString(typ), s)
+	}
+
+	return s
+}
+
+// convertAsCEL converts expr to a Go value of the Go type of its CEL type.
+func (c *celValidator) convertAsCEL(expr *exprpb.Expr, fieldName string) string {
+	return c.convertAs(expr, c.goTypeOfCEL(c.checked[expr.GetId()]), fieldName)
+}
+
+// stringOf returns s, the Go expression of expr, converted to string when expr has a named string
+// type, as the functions of the strings and regexp packages take a string.
+func (c *celValidator) stringOf(expr *exprpb.Expr, s string) string {
+	typ := c.goTypes[expr.GetId()]
+	if typ == nil || types.Identical(typ, types.Typ[types.String]) {
+		return s
+	}
+
+	if basic, ok := typ.Underlying().(*types.Basic); ok && basic.Info()&types.IsString != 0 {
+		return fmt.Sprintf("string(%s)", s)
+	}
+
+	return s
+}
+
+// convertString converts expr to a Go string.
+func (c *celValidator) convertString(expr *exprpb.Expr, fieldName string) string {
+	return c.stringOf(expr, c.convertASTToGo(expr, fieldName))
+}
+
+// callArgs returns the arguments of the function call, led by its target for member calls, such as
+// value.startsWith('prefix_').
+func callArgs(call *exprpb.Expr_Call) []*exprpb.Expr {
+	if call.Target == nil {
+		return call.Args
+	}
+
+	return append([]*exprpb.Expr{call.Target}, call.Args...)
 }
 
 // convertCallToGo converts CEL function calls to Go expressions.
-func (c *celValidator) convertCallToGo(callExpr *exprpb.Expr_Call, fieldName string) string {
-	function := callExpr.Function
-	args := callExpr.Args
+func (c *celValidator) convertCallToGo(expr *exprpb.Expr, fieldName string) string {
+	call := expr.GetCallExpr()
 
-	// Handle method calls with a target (e.g., value.startsWith('prefix_'))
-	if callExpr.Target != nil {
-		return c.convertMethodCall(function, callExpr.Target, args, fieldName)
+	if call.Target == nil {
+		// Try operators first
+		if result := c.convertOperator(expr, fieldName); result != "" {
+			return result
+		}
 	}
 
-	// Try operators first
-	if result := c.convertOperator(function, args, fieldName); result != "" {
+	// Try the custom functions, then the built-in functions and the extensions
+	if result := c.convertCustomFunction(expr, fieldName); result != "" {
 		return result
 	}
 
-	// Try built-in functions
-	if result := c.convertBuiltinFunction(function, fieldName, args); result != "" {
+	if result := c.convertBuiltinFunction(expr, fieldName); result != "" {
 		return result
 	}
 
-	// Fallback for unknown functions
-	return trueFallback
+	if result := c.convertExtFunction(expr, fieldName); result != "" {
+		return result
+	}
+
+	return c.unsupported(fmt.Sprintf("function %s", strings.TrimPrefix(call.Function, "@")))
 }
 
 // convertOperator converts CEL operators to Go operators.
-func (c *celValidator) convertOperator(function string, args []*exprpb.Expr, fieldName string) string {
-	// Handle ternary operator (condition ? true_value : false_value)
-	if function == ternaryOperator && len(args) == 3 {
-		return c.convertTernaryOperator(args, fieldName)
-	}
+func (c *celValidator) convertOperator(expr *exprpb.Expr, fieldName string) string {
+	function := expr.GetCallExpr().Function
+	args := expr.GetCallExpr().Args
 
-	// Handle 'in' operator
-	if function == "@in" && len(args) == 2 {
+	switch {
+	case function == ternaryOperator && len(args) == 3:
+		// Handle ternary operator (condition ? true_value : false_value)
+		return c.convertTernaryOperator(expr, fieldName)
+	case function == "@in" && len(args) == 2:
 		return c.convertInOperator(args, fieldName)
+	case function == "!_" && len(args) == 1:
+		return fmt.Sprintf("!(%s)", c.convertASTToGo(args[0], fieldName))
+	case function == "-_" && len(args) == 1:
+		c.goTypes[expr.GetId()] = c.goTypes[args[0].GetId()]
+
+		return fmt.Sprintf("-(%s)", c.convertASTToGo(args[0], fieldName))
+	case function == "_[_]" && len(args) == 2:
+		return c.convertIndexOperator(expr, fieldName)
 	}
 
 	if len(args) != 2 {
 		return ""
 	}
 
-	left := c.convertASTToGo(args[0], fieldName)
-	right := c.convertASTToGo(args[1], fieldName)
+	left, right, typ := c.convertOperands(args[0], args[1], fieldName)
+
+	// Timestamps are compared and shifted with the methods of time.Time
+	if c.celKind(args[0]) == celtypes.TimestampKind || c.celKind(args[1]) == celtypes.TimestampKind {
+		if result := c.convertTimestampOperator(function, args, left, right); result != "" {
+			return result
+		}
+	}
+
+	// Lists and maps are compared and concatenated with the functions of slices and maps
+	if c.celKind(args[0]) == celtypes.ListKind || c.celKind(args[0]) == celtypes.MapKind {
+		return c.convertCollectionOperator(function, args, fieldName)
+	}
 
 	// Try logical operators first
 	if result := c.convertLogicalOperator(function, left, right); result != "" {
@@ -304,75 +681,222 @@ func (c *celValidator) convertOperator(function string, args []*exprpb.Expr, fie
 		return result
 	}
 
-	// Try arithmetic operators
-	return c.convertArithmeticOperator(function, left, right)
+	// Try arithmetic operators, grouping the operands computed by other arithmetic operators
+	result := c.convertArithmeticOperator(function, group(args[0], left), group(args[1], right))
+	if result != "" {
+		c.goTypes[expr.GetId()] = typ
+	}
+
+	return result
 }
 
-func (c *celValidator) convertTernaryOperator(args []*exprpb.Expr, fieldName string) string {
-	condition := c.convertASTToGo(args[0], fieldName)
-	trueValue := c.convertASTToGo(args[1], fieldName)
-	falseValue := c.convertASTToGo(args[2], fieldName)
-	// Return the ternary result - it will be used in comparison context
-	// Since this is used in validation context, we need to handle it properly
-	return fmt.Sprintf("func() int { if %s { return %s }; return %s }() > 0", condition, trueValue, falseValue)
+// celNumberTypes are the Go types of the CEL numbers, to which operands of different Go types are converted.
+var celNumberTypes = map[celtypes.Kind]string{
+	celtypes.IntKind:    "int64",
+	celtypes.UintKind:   "uint64",
+	celtypes.DoubleKind: "float64",
 }
 
-func (c *celValidator) convertInOperator(args []*exprpb.Expr, fieldName string) string {
-	element := c.convertASTToGo(args[0], fieldName)
-	collection := c.convertASTToGo(args[1], fieldName)
+// convertOperands converts the operands of a binary operator, returning their common Go type when
+// known. Numbers of different CEL types, as allowed by cross-type comparisons, are converted to float64,
+// and numbers of different Go types, such as int and int32 fields, to the Go type of their CEL type.
+// Constants are left to the implicit conversions of Go.
+func (c *celValidator) convertOperands(lhs, rhs *exprpb.Expr, fieldName string) (string, string, types.Type) {
+	left := c.convertASTToGo(lhs, fieldName)
+	right := c.convertASTToGo(rhs, fieldName)
+
+	leftKind, rightKind := c.celKind(lhs), c.celKind(rhs)
+	leftType, rightType := c.operandType(lhs), c.operandType(rhs)
+
+	// The type of an operand is the type of the other one when it is a constant
+	typ := leftType
+	if typ == nil {
+		typ = rightType
+	}
+
+	_, leftNumber := celNumberTypes[leftKind]
+	_, rightNumber := celNumberTypes[rightKind]
 
-	// Optimize for string literal slices using slices.Contains
-	if strings.HasPrefix(collection, "[]interface{}{") && strings.HasSuffix(collection, "}") {
-		if optimized := c.optimizeStringSliceContains(collection, element); optimized != "" {
-			return optimized
+	if leftKind == celtypes.StringKind && rightKind == celtypes.StringKind {
+		if leftType != nil && rightType != nil && !types.Identical(leftType, rightType) {
+			return c.stringOf(lhs, left), c.stringOf(rhs, right), types.Typ[types.String]
 		}
+
+		return left, right, typ
 	}
 
-	// Optimize for field contains literal string: field contains "literal"
-	if strings.HasPrefix(collection, "t.") && strings.HasPrefix(element, "\"") && strings.HasSuffix(element, "\"") {
-		return fmt.Sprintf("slices.Contains(%s, %s)", collection, element)
+	if !leftNumber || !rightNumber {
+		return left, right, typ
+	}
+
+	goType := celNumberTypes[leftKind]
+	if leftKind != rightKind {
+		goType = celNumberTypes[celtypes.DoubleKind]
+	} else if leftType == nil || rightType == nil || types.Identical(leftType, rightType) {
+		return left, right, typ
 	}
 
-	// Generate a contains check for slices
-	return fmt.Sprintf("func() bool { for _, item := range %s { if item == %s { return true } }; return false }()", collection, element)
+	convert := func(expr *exprpb.Expr, s string) string {
+		if expr.GetConstExpr() != nil {
+			return s
+		}
+
+		return fmt.Sprintf("%s(%s)", goType, s)
+	}
+
+	return convert(lhs, left), convert(rhs, right), types.Universe.Lookup(goType).Type()
 }
 
-func (c *celValidator) optimizeStringSliceContains(collection, element string) string {
-	// Extract elements from []interface{}{"a", "b", "c"}
-	content := strings.TrimPrefix(collection, "[]interface{}{")
-	content = strings.TrimSuffix(content, "}")
+// operandType returns the Go type of the operand expr, or nil for constants, which are untyped in Go.
+func (c *celValidator) operandType(expr *exprpb.Expr) types.Type {
+	if expr.GetConstExpr() != nil {
+		return nil
+	}
+
+	return c.goType(expr)
+}
 
-	// Check if all elements are string literals
-	if !strings.Contains(content, "\"") {
+// convertTimestampOperator converts the comparisons of timestamps, and the arithmetic of timestamps
+// and durations, to the methods of time.Time.
+func (c *celValidator) convertTimestampOperator(function string, args []*exprpb.Expr, left, right string) string {
+	switch function {
+	case "_<_":
+		return fmt.Sprintf("%s.Before(%s)", left, right)
+	case "_>_":
+		return fmt.Sprintf("%s.After(%s)", left, right)
+	case "_<=_":
+		return fmt.Sprintf("!%s.After(%s)", left, right)
+	case "_>=_":
+		return fmt.Sprintf("!%s.Before(%s)", left, right)
+	case "_==_":
+		return fmt.Sprintf("%s.Equal(%s)", left, right)
+	case "_!=_":
+		return fmt.Sprintf("!%s.Equal(%s)", left, right)
+	case "_-_":
+		if c.celKind(args[1]) == celtypes.TimestampKind {
+			return fmt.Sprintf("%s.Sub(%s)", left, right)
+		}
+
+		return fmt.Sprintf("%s.Add(-(%s))", left, right)
+	case "_+_":
+		if c.celKind(args[0]) == celtypes.TimestampKind {
+			return fmt.Sprintf("%s.Add(%s)", left, right)
+		}
+
+		return fmt.Sprintf("%s.Add(%s)", right, left)
+	default:
 		return ""
 	}
+}
 
-	// Convert to string slice for optimization
-	stringSlice := fmt.Sprintf("[]string{%s}", content)
+// convertCollectionOperator converts the comparisons of lists and maps, and the concatenation of lists.
+// The right operand takes the Go type of the left one when it is a literal.
+func (c *celValidator) convertCollectionOperator(function string, args []*exprpb.Expr, fieldName string) string {
+	left := c.convertASTToGo(args[0], fieldName)
+	right := c.convertCollectionAs(args[1], c.goType(args[0]), fieldName)
+
+	pkg := "slices"
+	if c.celKind(args[0]) == celtypes.MapKind {
+		pkg = "maps"
+	}
 
-	// If element is fmt.Sprintf("%v", field), optimize for string fields
-	if strings.Contains(element, "fmt.Sprintf(\"%%v\", ") {
-		if fieldName := c.extractFieldFromFmtSprintf(element); fieldName != "" {
-			return fmt.Sprintf("slices.Contains(%s, %s)", stringSlice, fieldName)
+	switch function {
+	case "_==_":
+		c.use(pkg)
+
+		return fmt.Sprintf("%s.Equal(%s, %s)", pkg, left, right)
+	case "_!=_":
+		c.use(pkg)
+
+		return fmt.Sprintf("!%s.Equal(%s, %s)", pkg, left, right)
+	case "_+_":
+		if pkg == "slices" {
+			c.use(pkg)
+
+			return fmt.Sprintf("slices.Concat(%s, %s)", left, right)
 		}
 	}
 
-	return fmt.Sprintf("slices.Contains(%s, %s)", stringSlice, element)
+	return ""
 }
 
-func (c *celValidator) extractFieldFromFmtSprintf(element string) string {
-	// Extract the field name from fmt.Sprintf("%v", t.Field)
-	fieldStart := strings.Index(element, "t.")
-	if fieldStart == -1 {
-		return ""
+// convertCollectionAs converts expr, a list or a map, to the Go type typ when it is a literal.
+func (c *celValidator) convertCollectionAs(expr *exprpb.Expr, typ types.Type, fieldName string) string {
+	switch t := typ.Underlying().(type) {
+	case *types.Slice:
+		if expr.GetListExpr() != nil {
+			return c.convertListExpr(expr, t.Elem(), fieldName)
+		}
+	case *types.Map:
+		if expr.GetStructExpr() != nil {
+			return c.convertMapExpr(expr, fieldName)
+		}
 	}
 
-	fieldEnd := strings.Index(element[fieldStart:], ")")
-	if fieldEnd == -1 {
-		return ""
+	return c.convertAs(expr, typ, fieldName)
+}
+
+// convertTernaryOperator converts the conditional operator to a function literal returning the Go type
+// of its values.
+func (c *celValidator) convertTernaryOperator(expr *exprpb.Expr, fieldName string) string {
+	args := expr.GetCallExpr().Args
+
+	condition := c.convertASTToGo(args[0], fieldName)
+	trueValue := c.convertASTToGo(args[1], fieldName)
+
+	typ := c.goType(args[1])
+	if args[1].GetConstExpr() != nil {
+		c.convertASTToGo(args[2], fieldName)
+		typ = c.goType(args[2])
 	}
 
-	return element[fieldStart : fieldStart+fieldEnd]
+	falseValue := c.convertAs(args[2], typ, fieldName)
+	c.goTypes[expr.GetId()] = typ
+
+	return fmt.Sprintf("func() %s { if %s { return %s }; return %s }()", c.typeString(typ), condition, trueValue, falseValue)
+}
+
+// convertIndexOperator converts the indexing of lists and maps.
+func (c *celValidator) convertIndexOperator(expr *exprpb.Expr, fieldName string) string {
+	args := expr.GetCallExpr().Args
+
+	collection := c.convertASTToGo(args[0], fieldName)
+	c.goTypes[expr.GetId()] = c.elemType(args[0])
+
+	index := c.convertASTToGo(args[1], fieldName)
+	if t, ok := c.goType(args[0]).Underlying().(*types.Map); ok {
+		index = c.convertAs(args[1], t.Key(), fieldName)
+	}
+
+	return fmt.Sprintf("%s[%s]", collection, index)
+}
+
+// convertInOperator converts the membership tests of lists, with slices.Contains, and of the keys of maps.
+// Literal lists take the Go type of the element, such as a named string type.
+func (c *celValidator) convertInOperator(args []*exprpb.Expr, fieldName string) string {
+	element := c.convertASTToGo(args[0], fieldName)
+
+	switch t := c.goType(args[1]).Underlying().(type) {
+	case *types.Map:
+		collection := c.convertASTToGo(args[1], fieldName)
+
+		return fmt.Sprintf("func() bool { _, ok := %s[%s]; return ok }()", collection, c.convertAs(args[0], t.Key(), fieldName))
+	case *types.Slice:
+		var collection string
+
+		if args[1].GetListExpr() != nil && args[0].GetConstExpr() == nil {
+			collection = c.convertListExpr(args[1], c.goType(args[0]), fieldName)
+		} else {
+			collection = c.convertASTToGo(args[1], fieldName)
+			element = c.convertAs(args[0], t.Elem(), fieldName)
+		}
+
+		c.use("slices")
+
+		return fmt.Sprintf("slices.Contains(%s, %s)", collection, element)
+	}
+
+	return c.unsupported("in")
 }
 
 // convertLogicalOperator converts logical operators.
@@ -407,6 +931,19 @@ func (c *celValidator) convertComparisonOperator(function, left, right string) s
 	}
 }
 
+// celArithmeticOperators are the arithmetic operators of CEL.
+var celArithmeticOperators = []string{"_+_", "_-_", "_*_", "_/_", "_%_"}
+
+// group returns s, the Go expression of expr, in parentheses when expr is an arithmetic operation,
+// preserving the grouping of the CEL expression.
+func group(expr *exprpb.Expr, s string) string {
+	if call := expr.GetCallExpr(); call != nil && slices.Contains(celArithmeticOperators, call.Function) {
+		return "(" + s + ")"
+	}
+
+	return s
+}
+
 // convertArithmeticOperator converts arithmetic operators.
 func (c *celValidator) convertArithmeticOperator(function, left, right string) string {
 	switch function {
@@ -418,141 +955,187 @@ func (c *celValidator) convertArithmeticOperator(function, left, right string) s
 		return fmt.Sprintf("%s * %s", left, right)
 	case "_/_":
 		return fmt.Sprintf("%s / %s", left, right)
+	case "_%_":
+		return fmt.Sprintf("%s %% %s", left, right)
 	default:
 		return ""
 	}
 }
 
 // convertBuiltinFunction converts CEL built-in functions to Go equivalents.
-func (c *celValidator) convertBuiltinFunction(function, fieldName string, args []*exprpb.Expr) string {
-	switch function {
-	case "size":
-		return c.convertSizeFunction(fieldName, args)
-	case "contains":
-		return c.convertContainsFunction(fieldName, args)
-	case "matches":
-		return c.convertMatchesFunction(fieldName, args)
-	case "startsWith":
-		return c.convertStartsWithFunction(fieldName, args)
-	case "endsWith":
-		return c.convertEndsWithFunction(fieldName, args)
-	case "int":
-		return c.convertIntFunction(fieldName, args)
-	case "string":
-		return c.convertStringFunction(fieldName, args)
-	case "double":
-		return c.convertDoubleFunction(fieldName, args)
-	case "timestamp":
-		return c.convertTimestampFunction(fieldName, args)
-	case "duration":
-		return c.convertDurationFunction(fieldName, args)
-	}
+func (c *celValidator) convertBuiltinFunction(expr *exprpb.Expr, fieldName string) string {
+	call := expr.GetCallExpr()
+	args := callArgs(call)
+
+	if len(args) == 1 {
+		switch call.Function {
+		case "size":
+			return c.convertSizeFunction(expr, args[0], fieldName)
+		case "int":
+			return c.convertIntFunction(expr, args[0], fieldName)
+		case "uint":
+			return c.convertUintFunction(args[0], fieldName)
+		case "double":
+			return c.convertDoubleFunction(args[0], fieldName)
+		case "string":
+			return c.convertStringFunction(expr, args[0], fieldName)
+		case "bool":
+			return c.convertBoolFunction(args[0], fieldName)
+		case "dyn":
+			return c.convertASTToGo(args[0], fieldName)
+		case "timestamp":
+			return c.convertTimestampFunction(args[0], fieldName)
+		case "duration":
+			return c.convertDurationFunction(args[0], fieldName)
+		}
 
-	return ""
-}
+		if result := c.convertTimeAccessor(call.Function, args[0], fieldName); result != "" {
+			return result
+		}
+	}
 
-func (c *celValidator) convertSizeFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 1 {
+	if len(args) != 2 {
 		return ""
 	}
 
-	arg := c.convertASTToGo(args[0], fieldName)
+	switch call.Function {
+	case "contains":
+		c.use("strings")
+
+		return fmt.Sprintf("strings.Contains(%s, %s)", c.convertString(args[0], fieldName), c.convertString(args[1], fieldName))
+	case "startsWith":
+		c.use("strings")
 
-	return fmt.Sprintf("len(%s)", arg)
-}
+		return fmt.Sprintf("strings.HasPrefix(%s, %s)", c.convertString(args[0], fieldName), c.convertString(args[1], fieldName))
+	case "endsWith":
+		c.use("strings")
 
-func (c *celValidator) convertContainsFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 2 {
-		return ""
-	}
+		return fmt.Sprintf("strings.HasSuffix(%s, %s)", c.convertString(args[0], fieldName), c.convertString(args[1], fieldName))
+	case "matches":
+		c.use("regexp")
 
-	str := c.convertASTToGo(args[0], fieldName)
-	substr := c.convertASTToGo(args[1], fieldName)
+		return fmt.Sprintf("regexp.MustCompile(%s).MatchString(%s)", c.convertString(args[1], fieldName), c.convertString(args[0], fieldName))
+	}
 
-	return fmt.Sprintf("strings.Contains(%s, %s)", str, substr)
+	return ""
 }
 
-func (c *celValidator) convertMatchesFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 2 {
-		return ""
-	}
+// convertSizeFunction converts size to len, or to the count of the code points of strings, of Go type int.
+func (c *celValidator) convertSizeFunction(expr, arg *exprpb.Expr, fieldName string) string {
+	c.goTypes[expr.GetId()] = types.Typ[types.Int]
+
+	if c.celKind(arg) == celtypes.StringKind {
+		c.use("unicode/utf8")
 
-	str := c.convertASTToGo(args[0], fieldName)
-	pattern := c.convertASTToGo(args[1], fieldName)
+		return fmt.Sprintf("utf8.RuneCountInString(%s)", c.convertString(arg, fieldName))
+	}
 
-	return fmt.Sprintf("regexp.MustCompile(%s).MatchString(%s)", pattern, str)
+	return fmt.Sprintf("len(%s)", c.convertASTToGo(arg, fieldName))
 }
 
-func (c *celValidator) convertStartsWithFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 2 {
-		return ""
+func (c *celValidator) convertIntFunction(expr, arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+	c.goTypes[expr.GetId()] = types.Typ[types.Int]
+
+	switch c.celKind(arg) {
+	case celtypes.TimestampKind:
+		return fmt.Sprintf("int(%s.Unix())", s)
+	case celtypes.IntKind, celtypes.UintKind, celtypes.DoubleKind, celtypes.DurationKind:
+		return fmt.Sprintf("int(%s)", s)
 	}
 
-	str := c.convertASTToGo(args[0], fieldName)
-	prefix := c.convertASTToGo(args[1], fieldName)
+	c.use("strconv")
 
-	return fmt.Sprintf("strings.HasPrefix(%s, %s)", str, prefix)
+	return fmt.Sprintf("func() int { v, err := strconv.Atoi(%s); if err != nil { return 0 }; return v }()", c.stringOf(arg, s))
 }
 
-func (c *celValidator) convertEndsWithFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 2 {
-		return ""
+func (c *celValidator) convertUintFunction(arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+
+	if _, ok := celNumberTypes[c.celKind(arg)]; ok {
+		return fmt.Sprintf("uint64(%s)", s)
 	}
 
-	str := c.convertASTToGo(args[0], fieldName)
-	suffix := c.convertASTToGo(args[1], fieldName)
+	c.use("strconv")
 
-	return fmt.Sprintf("strings.HasSuffix(%s, %s)", str, suffix)
+	return fmt.Sprintf("func() uint64 { v, err := strconv.ParseUint(%s, 10, 64); if err != nil { return 0 }; return v }()", c.stringOf(arg, s))
 }
 
-func (c *celValidator) convertIntFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 1 {
-		return ""
+func (c *celValidator) convertDoubleFunction(arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+
+	if _, ok := celNumberTypes[c.celKind(arg)]; ok {
+		return fmt.Sprintf("float64(%s)", s)
 	}
 
-	arg := c.convertASTToGo(args[0], fieldName)
+	c.use("strconv")
+	c.use("fmt")
 
-	return fmt.Sprintf("func() int { v, err := strconv.Atoi(%s); if err != nil { return 0 }; return v }()", arg)
+	return fmt.Sprintf("func() float64 { v, err := strconv.ParseFloat(fmt.Sprintf(\"%%v\", %s), 64); if err != nil { return 0.0 }; return v }()", s)
 }
 
-func (c *celValidator) convertStringFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 1 {
-		return ""
+// convertStringFunction converts string, formatting timestamps in RFC 3339 and durations in seconds,
+// as cel-go does.
+func (c *celValidator) convertStringFunction(expr, arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+	c.goTypes[expr.GetId()] = types.Typ[types.String]
+
+	switch c.celKind(arg) {
+	case celtypes.StringKind:
+		return c.stringOf(arg, s)
+	case celtypes.BytesKind:
+		return fmt.Sprintf("string(%s)", s)
+	case celtypes.BoolKind:
+		c.use("strconv")
+
+		return fmt.Sprintf("strconv.FormatBool(%s)", s)
+	case celtypes.TimestampKind:
+		c.use("time")
+
+		return fmt.Sprintf("%s.Format(time.RFC3339Nano)", s)
+	case celtypes.DurationKind:
+		c.use("strconv")
+
+		return fmt.Sprintf("strconv.FormatFloat(%s.Seconds(), 'f', -1, 64) + \"s\"", s)
 	}
 
-	arg := c.convertASTToGo(args[0], fieldName)
+	c.use("fmt")
 
-	return fmt.Sprintf("fmt.Sprintf(\"%%v\", %s)", arg)
+	return fmt.Sprintf("fmt.Sprintf(\"%%v\", %s)", s)
 }
 
-func (c *celValidator) convertDoubleFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 1 {
-		return ""
+func (c *celValidator) convertBoolFunction(arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+
+	if c.celKind(arg) == celtypes.BoolKind {
+		return s
 	}
 
-	arg := c.convertASTToGo(args[0], fieldName)
+	c.use("strconv")
 
-	return fmt.Sprintf("func() float64 { v, err := strconv.ParseFloat(fmt.Sprintf(\"%%v\", %s), 64); if err != nil { return 0.0 }; return v }()", arg)
+	return fmt.Sprintf("func() bool { v, err := strconv.ParseBool(%s); if err != nil { return false }; return v }()", c.stringOf(arg, s))
 }
 
-func (c *celValidator) convertTimestampFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 1 {
-		return ""
+func (c *celValidator) convertTimestampFunction(arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+	if c.celKind(arg) == celtypes.TimestampKind {
+		return s
 	}
 
-	arg := c.convertASTToGo(args[0], fieldName)
+	c.use("time")
 
-	return fmt.Sprintf("func() time.Time { t, err := time.Parse(time.RFC3339, %s); if err != nil { return time.Time{} }; return t }()", arg)
+	return fmt.Sprintf("func() time.Time { t, err := time.Parse(time.RFC3339, %s); if err != nil { return time.Time{} }; return t }()", s)
 }
 
-func (c *celValidator) convertDurationFunction(fieldName string, args []*exprpb.Expr) string {
-	if len(args) != 1 {
-		return ""
+func (c *celValidator) convertDurationFunction(arg *exprpb.Expr, fieldName string) string {
+	s := c.convertASTToGo(arg, fieldName)
+	if c.celKind(arg) == celtypes.DurationKind {
+		return s
 	}
 
-	arg := c.convertASTToGo(args[0], fieldName)
+	c.use("time")
 
-	return fmt.Sprintf("func() time.Duration { d, err := time.ParseDuration(%s); if err != nil { return 0 }; return d }()", arg)
+	return fmt.Sprintf("func() time.Duration { d, err := time.ParseDuration(%s); if err != nil { return 0 }; return d }()", s)
 }
 
 // convertConstToGo converts CEL constants to Go literals.
@@ -579,67 +1162,84 @@ func (c *celValidator) convertConstToGo(constExpr *exprpb.Constant) string {
 	}
 }
 
-// convertMethodCall converts method calls with a target (e.g., value.startsWith('prefix_')).
-func (c *celValidator) convertMethodCall(method string, target *exprpb.Expr, args []*exprpb.Expr, fieldName string) string {
-	targetStr := c.convertASTToGo(target, fieldName)
+// convertListExpr converts list expressions like ['a', 'b', 'c'] to slices of the Go type elem, or else
+// of the common Go type of the elements, or of the Go type of their CEL type.
+func (c *celValidator) convertListExpr(expr *exprpb.Expr, elem types.Type, fieldName string) string {
+	listExpr := expr.GetListExpr()
 
-	switch method {
-	case "startsWith":
-		if len(args) == 1 {
-			prefix := c.convertASTToGo(args[0], fieldName)
+	if elem == nil {
+		for _, element := range listExpr.Elements {
+			c.convertASTToGo(element, fieldName)
 
-			return fmt.Sprintf("strings.HasPrefix(%s, %s)", targetStr, prefix)
-		}
-	case "endsWith":
-		if len(args) == 1 {
-			suffix := c.convertASTToGo(args[0], fieldName)
+			typ := c.goTypes[element.GetId()]
+			if typ == nil {
+				continue
+			}
 
-			return fmt.Sprintf("strings.HasSuffix(%s, %s)", targetStr, suffix)
-		}
-	case "contains":
-		if len(args) == 1 {
-			substr := c.convertASTToGo(args[0], fieldName)
+			if elem != nil && !types.Identical(elem, typ) {
+				elem = nil
 
-			return fmt.Sprintf("strings.Contains(%s, %s)", targetStr, substr)
-		}
-	case "matches":
-		if len(args) == 1 {
-			pattern := c.convertASTToGo(args[0], fieldName)
+				break
+			}
 
-			return fmt.Sprintf("regexp.MustCompile(%s).MatchString(%s)", pattern, targetStr)
+			elem = typ
 		}
 	}
 
-	// Fallback for unknown method calls
-	return trueFallback
-}
+	if elem == nil {
+		if typ := c.checked[expr.GetId()]; typ != nil && typ.Kind() == celtypes.ListKind {
+			elem = c.goTypeOfCEL(typ.Parameters()[0])
+		} else {
+			elem = anyType
+		}
+	}
 
-// convertListExpr converts list expressions like ['a', 'b', 'c'].
-func (c *celValidator) convertListExpr(listExpr *exprpb.Expr_CreateList, fieldName string) string {
 	elements := make([]string, len(listExpr.Elements))
 	for i, element := range listExpr.Elements {
-		elements[i] = c.convertASTToGo(element, fieldName)
+		elements[i] = c.convertAs(element, elem, fieldName)
+	}
+
+	c.goTypes[expr.GetId()] = types.NewSlice(elem)
+
+	return fmt.Sprintf("[]%s{%s}", c.typeString(elem), strings.Join(elements, ", "))
+}
+
+// convertMapExpr converts map expressions like {'a': 1} to maps of the Go types of their CEL types.
+// Messages cannot be created.
+func (c *celValidator) convertMapExpr(expr *exprpb.Expr, fieldName string) string {
+	structExpr := expr.GetStructExpr()
+	if structExpr.MessageName != "" {
+		return c.unsupported("message " + structExpr.MessageName)
+	}
+
+	typ, ok := c.goTypeOfCEL(c.checked[expr.GetId()]).(*types.Map)
+	if !ok {
+		return c.unsupported("map")
+	}
+
+	entries := make([]string, len(structExpr.Entries))
+	for i, entry := range structExpr.Entries {
+		entries[i] = fmt.Sprintf("%s: %s", c.convertAs(entry.GetMapKey(), typ.Key(), fieldName), c.convertAs(entry.Value, typ.Elem(), fieldName))
 	}
 
-	return fmt.Sprintf("[]interface{}{%s}", strings.Join(elements, ", "))
+	return fmt.Sprintf("%s{%s}", c.typeString(typ), strings.Join(entries, ", "))
 }
 
 // validateStandardCEL validates that the expression uses only standard CEL features.
 func (c *celValidator) validateStandardCEL(celExpr string) error {
 	// List of non-standard patterns to reject
 	nonStandardPatterns := []string{
-		// String methods not in CEL standard
-		".split(", ".trim(", ".replace(", ".substring(",
+		// String methods not in CEL
 		".toLowerCase(", ".toUpperCase(",
 
-		// Math functions not in CEL standard
-		"math.abs(", "math.min(", "math.max(", "math.floor(", "math.ceil(",
+		// Math functions not in CEL, which has math.least and math.greatest
+		"math.min(", "math.max(",
 
-		// Advanced syntax not in CEL standard
-		"?.", "?:", "try(", "..", "range(",
+		// Advanced syntax not in CEL
+		"?:", "try(", "..",
 
-		// List/Map methods not in CEL standard
-		".reverse(", ".sort(", ".unique(", ".keys(", ".values(",
+		// List/Map methods not in CEL
+		".unique(", ".keys(", ".values(",
 
 		// String interpolation syntax not in standard CEL
 		"${",
@@ -663,190 +1263,83 @@ func (c *celValidator) validateStandardCEL(celExpr string) error {
 	return nil
 }
 
-// convertComprehensionExpr converts CEL list comprehension expressions to Go equivalents.
-func (c *celValidator) convertComprehensionExpr(comprExpr *exprpb.Expr_Comprehension, fieldName string) string {
-	// Get the iteration variable and collection
+// convertComprehensionExpr converts the comprehensions of the macros all, exists, exists_one, filter
+// and map to function literals ranging over the elements of lists, or the keys of maps. The macro is
+// recognized from the initial value of the accumulator and the loop step.
+func (c *celValidator) convertComprehensionExpr(expr *exprpb.Expr, fieldName string) string {
+	comprExpr := expr.GetComprehensionExpr()
 	iterVar := comprExpr.IterVar
 	iterRange := c.convertASTToGo(comprExpr.IterRange, fieldName)
 
-	// Determine the type of comprehension based on the structure
-	return c.generateComprehensionGo(comprExpr, iterVar, iterRange, fieldName)
-}
+	// The variable ranges over the elements of lists and the keys of maps
+	rangeClause := "_, " + iterVar
+	if t, ok := c.goType(comprExpr.IterRange).Underlying().(*types.Map); ok {
+		rangeClause = iterVar
+		c.locals[iterVar] = t.Key()
+	} else {
+		c.locals[iterVar] = c.elemType(comprExpr.IterRange)
+	}
 
-// generateComprehensionGo generates Go code for different types of comprehensions.
-func (c *celValidator) generateComprehensionGo(comprExpr *exprpb.Expr_Comprehension, iterVar, iterRange, fieldName string) string {
-	// Get the loop condition and step to determine comprehension type
-	accuInit := c.convertASTToGo(comprExpr.AccuInit, fieldName)
-	loopStep := c.convertASTToGo(comprExpr.LoopStep, fieldName)
+	step := comprExpr.LoopStep.GetCallExpr()
+	accuInit := comprExpr.AccuInit
 
-	// Determine comprehension type based on initial accumulator
 	switch {
-	case accuInit == "true":
+	case step == nil:
+	case accuInit.GetConstExpr() != nil && step.Function == "_&&_" && len(step.Args) == 2:
 		// items.all(item, condition) - starts with true, AND operation
-		return c.generateAllComprehension(comprExpr, iterVar, iterRange, fieldName)
-	case accuInit == "false":
-		// items.exists(item, condition) - starts with false, OR operation
-		return c.generateExistsComprehension(comprExpr, iterVar, iterRange, fieldName)
-	case strings.Contains(accuInit, "[]interface{}"):
-		// items.filter(item, condition) or items.map(item, transform) - starts with empty list
-		if strings.Contains(loopStep, "func() int { if") {
-			return c.generateFilterComprehension(comprExpr, iterVar, iterRange, fieldName)
-		}
+		condition := c.convertASTToGo(step.Args[1], fieldName)
 
-		return c.generateMapComprehension(comprExpr, iterVar, iterRange, fieldName)
-	default:
-		// Could be exists_one or other complex comprehension
-		return c.generateExistsOneComprehension(comprExpr, iterVar, iterRange, fieldName)
-	}
-}
-
-// generateAllComprehension generates Go code for items.all(item, condition).
-func (c *celValidator) generateAllComprehension(comprExpr *exprpb.Expr_Comprehension, iterVar, iterRange, fieldName string) string {
-	// Extract the actual condition from loop step (it's the right side of &&)
-	condition := c.extractConditionFromAndStep(comprExpr.LoopStep, fieldName)
-
-	return fmt.Sprintf("func() bool { for _, %s := range %s { if !(%s) { return false } }; return true }()",
-		iterVar, iterRange, condition)
-}
-
-// generateExistsComprehension generates Go code for items.exists(item, condition).
-func (c *celValidator) generateExistsComprehension(comprExpr *exprpb.Expr_Comprehension, iterVar, iterRange, fieldName string) string {
-	// Extract the actual condition from loop step (it's the right side of ||)
-	condition := c.extractConditionFromOrStep(comprExpr.LoopStep, fieldName)
-
-	return fmt.Sprintf("func() bool { for _, %s := range %s { if %s { return true } }; return false }()",
-		iterVar, iterRange, condition)
-}
-
-// generateFilterComprehension generates Go code for items.filter(item, condition).
-func (c *celValidator) generateFilterComprehension(comprExpr *exprpb.Expr_Comprehension, iterVar, iterRange, fieldName string) string {
-	// Extract the condition from the ternary operation in loop step
-	condition := c.extractConditionFromTernary(comprExpr.LoopStep, iterVar, fieldName)
-
-	return fmt.Sprintf("func() []interface{} { var result []interface{}; for _, %s := range %s { if %s { result = append(result, %s) } }; return result }()",
-		iterVar, iterRange, condition, iterVar)
-}
-
-// generateMapComprehension generates Go code for items.map(item, transform).
-func (c *celValidator) generateMapComprehension(comprExpr *exprpb.Expr_Comprehension, iterVar, iterRange, fieldName string) string {
-	// Extract the transformation from loop step
-	transform := c.extractTransformFromLoopStep(comprExpr.LoopStep, iterVar, fieldName)
-
-	return fmt.Sprintf("func() []interface{} { var result []interface{}; for _, %s := range %s { result = append(result, %s) }; return result }()",
-		iterVar, iterRange, transform)
-}
-
-// generateExistsOneComprehension generates Go code for items.exists_one(item, condition).
-func (c *celValidator) generateExistsOneComprehension(comprExpr *exprpb.Expr_Comprehension, iterVar, iterRange, fieldName string) string {
-	// For exists_one, the condition is embedded in a ternary within loop step
-	condition := c.extractConditionFromExistsOneTernary(comprExpr.LoopStep, iterVar, fieldName)
-
-	return fmt.Sprintf("func() bool { count := 0; for _, %s := range %s { if %s { count++; if count > 1 { return false } } }; return count == 1 }()",
-		iterVar, iterRange, condition)
-}
-
-func (c *celValidator) extractConditionFromTernary(loopStep *exprpb.Expr, iterVar, fieldName string) string {
-	// Extract condition from ternary operation used in filter
-	if callExpr := loopStep.GetCallExpr(); callExpr != nil {
-		function := callExpr.Function
-		args := callExpr.Args
-
-		// For ternary operations _?_:_, the first arg is the condition
-		if function == ternaryOperator && len(args) >= 1 {
-			return c.convertASTToGo(args[0], fieldName)
-		}
-	}
-
-	// For function literal with ternary, parse the structure differently
-	loopStepStr := c.convertASTToGo(loopStep, fieldName)
-	if strings.Contains(loopStepStr, "if ") && strings.Contains(loopStepStr, " { return") {
-		// Extract condition from "func() int { if condition { return ... }; return @result }()"
-		start := strings.Index(loopStepStr, "if ") + 3
-		end := strings.Index(loopStepStr[start:], " { return")
-
-		if start < len(loopStepStr) && end > 0 {
-			return strings.TrimSpace(loopStepStr[start : start+end])
-		}
+		return fmt.Sprintf("func() bool { for %s := range %s { if !(%s) { return false } }; return true }()",
+			rangeClause, iterRange, condition)
+	case accuInit.GetConstExpr() != nil && step.Function == "_||_" && len(step.Args) == 2:
+		// items.exists(item, condition) - starts with false, OR operation
+		condition := c.convertASTToGo(step.Args[1], fieldName)
+
+		return fmt.Sprintf("func() bool { for %s := range %s { if %s { return true } }; return false }()",
+			rangeClause, iterRange, condition)
+	case accuInit.GetConstExpr() != nil && step.Function == ternaryOperator && len(step.Args) == 3:
+		// items.exists_one(item, condition) - counts the elements
+		condition := c.convertASTToGo(step.Args[0], fieldName)
+
+		return fmt.Sprintf("func() bool { count := 0; for %s := range %s { if %s { count++; if count > 1 { return false } } }; return count == 1 }()",
+			rangeClause, iterRange, condition)
+	case accuInit.GetListExpr() != nil:
+		// items.filter(item, condition) and items.map(item, [condition,] transform) - append to a list
+		return c.convertListComprehension(expr, rangeClause, iterRange, fieldName)
 	}
 
-	// Fallback condition
-	return fmt.Sprintf("%s != nil", iterVar)
+	return c.unsupported("comprehension")
 }
 
-func (c *celValidator) extractTransformFromLoopStep(loopStep *exprpb.Expr, iterVar, fieldName string) string {
-	// Extract transformation from loop step for map operations
-	if callExpr := loopStep.GetCallExpr(); callExpr != nil {
-		function := callExpr.Function
-		args := callExpr.Args
-
-		// For list append operations in map, look for the value being appended
-		if function == "_+_" && len(args) >= 2 {
-			// Usually the second argument is the transform
-			if listExpr := args[1].GetListExpr(); listExpr != nil && len(listExpr.Elements) > 0 {
-				return c.convertASTToGo(listExpr.Elements[0], fieldName)
-			}
-		}
-	}
-
-	// Parse from string representation for map operations like "@result + []interface{}{len(item)}"
-	loopStepStr := c.convertASTToGo(loopStep, fieldName)
-	if strings.Contains(loopStepStr, "+ []interface{}{") {
-		start := strings.Index(loopStepStr, "+ []interface{}{") + len("+ []interface{}{")
-		end := strings.LastIndex(loopStepStr, "}")
-
-		if start < len(loopStepStr) && end > start {
-			return strings.TrimSpace(loopStepStr[start:end])
-		}
-	}
-
-	// Fallback transformation - convert item to itself
-	return iterVar
-}
+// convertListComprehension converts the comprehensions appending to a list, the filter and map macros,
+// returning a slice of the Go type of the appended elements.
+func (c *celValidator) convertListComprehension(expr *exprpb.Expr, rangeClause, iterRange, fieldName string) string {
+	step := expr.GetComprehensionExpr().LoopStep
 
-// extractConditionFromAndStep extracts the condition from the right side of && operation.
-func (c *celValidator) extractConditionFromAndStep(loopStep *exprpb.Expr, fieldName string) string {
-	if callExpr := loopStep.GetCallExpr(); callExpr != nil {
-		function := callExpr.Function
-		args := callExpr.Args
+	var condition string
 
-		// For && operation, we want the right side (the actual condition)
-		if function == "_&&_" && len(args) >= 2 {
-			return c.convertASTToGo(args[1], fieldName)
-		}
+	if call := step.GetCallExpr(); call.Function == ternaryOperator && len(call.Args) == 3 {
+		condition = c.convertASTToGo(call.Args[0], fieldName)
+		step = call.Args[1]
 	}
 
-	// Fallback - return the whole expression
-	return c.convertASTToGo(loopStep, fieldName)
-}
-
-// extractConditionFromOrStep extracts the condition from the right side of || operation.
-func (c *celValidator) extractConditionFromOrStep(loopStep *exprpb.Expr, fieldName string) string {
-	if callExpr := loopStep.GetCallExpr(); callExpr != nil {
-		function := callExpr.Function
-		args := callExpr.Args
-
-		// For || operation, we want the right side (the actual condition)
-		if function == "_||_" && len(args) >= 2 {
-			return c.convertASTToGo(args[1], fieldName)
-		}
+	// The step appends a list of the element to the accumulator
+	call := step.GetCallExpr()
+	if call == nil || call.Function != "_+_" || len(call.Args) != 2 || len(call.Args[1].GetListExpr().GetElements()) != 1 {
+		return c.unsupported("comprehension")
 	}
 
-	// Fallback - return the whole expression
-	return c.convertASTToGo(loopStep, fieldName)
-}
+	element := call.Args[1].GetListExpr().GetElements()[0]
+	transform := c.convertASTToGo(element, fieldName)
 
-// extractConditionFromExistsOneTernary extracts condition from ternary in exists_one.
-func (c *celValidator) extractConditionFromExistsOneTernary(loopStep *exprpb.Expr, iterVar, fieldName string) string {
-	if callExpr := loopStep.GetCallExpr(); callExpr != nil {
-		function := callExpr.Function
-		args := callExpr.Args
+	typ := c.goType(element)
+	c.goTypes[expr.GetId()] = types.NewSlice(typ)
 
-		// For ternary operation, the condition is the first argument
-		if function == ternaryOperator && len(args) >= 1 {
-			return c.convertASTToGo(args[0], fieldName)
-		}
+	body := fmt.Sprintf("result = append(result, %s)", transform)
+	if condition != "" {
+		body = fmt.Sprintf("if %s { %s }", condition, body)
 	}
 
-	// Fallback
-	return fmt.Sprintf("%s != nil", iterVar)
+	return fmt.Sprintf("func() []%[1]s { var result []%[1]s; for %[2]s := range %[3]s { %[4]s }; return result }()",
+		c.typeString(typ), rangeClause, iterRange, body)
 }
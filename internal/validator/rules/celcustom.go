@@ -0,0 +1,137 @@
+package rules
+
+import (
+	"fmt"
+	"go/types"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+	celtypes "github.com/google/cel-go/common/types"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+
+	analyzermarkers "github.com/templatedop/govalid/internal/analyzers/markers"
+)
+
+// celCustomFunctions returns the Go functions declared as CEL functions with +govalid:celfunc, in the
+// package or in the packages it imports, by CEL function name.
+func (c *celValidator) celCustomFunctions() map[string]*types.Func {
+	if c.pass == nil {
+		return nil
+	}
+
+	result, ok := c.pass.ResultOf[analyzermarkers.Analyzer].(analyzermarkers.Markers)
+	if !ok {
+		return nil
+	}
+
+	return result.CELFunctions()
+}
+
+// declareCustomFunctions returns the declarations of the custom CEL functions, with the CEL types of
+// the parameters and the result of their Go functions. A function whose signature has no CEL
+// equivalent fails the conversion of the expressions calling it, and is left undeclared otherwise.
+func (c *celValidator) declareCustomFunctions(celExpr string) ([]cel.EnvOption, error) {
+	functions := c.celCustomFunctions()
+	c.functions = map[string]*types.Func{}
+
+	var declarations []cel.EnvOption
+
+	for _, name := range slices.Sorted(maps.Keys(functions)) {
+		fn := functions[name]
+
+		params, result, err := c.celSignature(fn)
+		if err != nil {
+			if regexp.MustCompile(`(^|[^\w.])` + regexp.QuoteMeta(name) + `\s*\(`).MatchString(celExpr) {
+				return nil, fmt.Errorf("CEL function %s bound to %s: %w", name, fn.FullName(), err)
+			}
+
+			continue
+		}
+
+		c.functions[name] = fn
+		declarations = append(declarations, cel.Function(name, cel.Overload(fn.FullName(), params, result)))
+	}
+
+	return declarations, nil
+}
+
+// celSignature returns the CEL types of the parameters and of the result of the Go function fn, which
+// takes values of CEL types, excluding structs, and returns a single value of a CEL type.
+func (c *celValidator) celSignature(fn *types.Func) ([]*celtypes.Type, *celtypes.Type, error) {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok {
+		return nil, nil, fmt.Errorf("not a function")
+	}
+
+	if sig.Variadic() {
+		return nil, nil, fmt.Errorf("variadic functions are not supported")
+	}
+
+	if sig.Results().Len() != 1 {
+		return nil, nil, fmt.Errorf("the function must return a single value")
+	}
+
+	params := make([]*celtypes.Type, 0, sig.Params().Len())
+
+	for i := range sig.Params().Len() {
+		typ, err := c.celTypeOfParam(sig.Params().At(i).Type())
+		if err != nil {
+			return nil, nil, fmt.Errorf("parameter %d: %w", i+1, err)
+		}
+
+		params = append(params, typ)
+	}
+
+	result, err := c.celTypeOfParam(sig.Results().At(0).Type())
+	if err != nil {
+		return nil, nil, fmt.Errorf("result: %w", err)
+	}
+
+	return params, result, nil
+}
+
+// celTypeOfParam returns the CEL type of typ, a parameter or result type of a custom CEL function.
+// Pointers, structs and types without a CEL equivalent, such as interfaces, are not supported.
+func (c *celValidator) celTypeOfParam(typ types.Type) (*celtypes.Type, error) {
+	if _, ok := typ.Underlying().(*types.Pointer); ok {
+		return nil, fmt.Errorf("type %s is not supported", typ)
+	}
+
+	celType := c.structs.celType(typ)
+	if celType == celtypes.DynType || celType.Kind() == celtypes.StructKind {
+		return nil, fmt.Errorf("type %s is not supported", typ)
+	}
+
+	return celType, nil
+}
+
+// convertCustomFunction converts a call of a custom CEL function to a call of its Go function, converting
+// the arguments to the types of its parameters, and importing its package.
+func (c *celValidator) convertCustomFunction(expr *exprpb.Expr, fieldName string) string {
+	call := expr.GetCallExpr()
+
+	fn, ok := c.functions[call.Function]
+	if !ok || call.Target != nil {
+		return ""
+	}
+
+	sig, _ := fn.Type().(*types.Signature)
+
+	args := make([]string, 0, len(call.Args))
+	for i, arg := range call.Args {
+		args = append(args, c.convertCollectionAs(arg, sig.Params().At(i).Type(), fieldName))
+	}
+
+	c.goTypes[expr.GetId()] = sig.Results().At(0).Type()
+
+	name := fn.Name()
+	if fn.Pkg() != c.pass.Pkg {
+		c.use(fn.Pkg().Path())
+		name = fn.Pkg().Name() + "." + name
+	}
+
+	return fmt.Sprintf("%s(%s)", name, strings.Join(args, ", "))
+}
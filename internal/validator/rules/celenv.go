@@ -0,0 +1,231 @@
+package rules
+
+import (
+	"fmt"
+	"go/types"
+	"reflect"
+	"strings"
+
+	celtypes "github.com/google/cel-go/common/types"
+)
+
+// celStructs is the CEL type provider of the structs referenced by a CEL rule, declaring their fields
+// with the CEL types of their Go types, so the expressions are type-checked at generation. Fields are
+// selected by their Go name or their JSON name. Other types are provided by the embedded registry.
+type celStructs struct {
+	*celtypes.Registry
+
+	// structs are the declared structs by CEL type name.
+	structs map[string]*celStruct
+	// names are the CEL type names of the declared Go types.
+	names map[types.Type]string
+}
+
+// celStruct is a struct declared in a CEL environment.
+type celStruct struct {
+	// fields are the fields by Go name and JSON name.
+	fields map[string]celField
+	// names are the Go names of the fields, in the order of the struct.
+	names []string
+}
+
+// celField is a field of a struct declared in a CEL environment.
+type celField struct {
+	// name is the Go name of the field.
+	name string
+	typ  types.Type
+}
+
+func newCELStructs() (*celStructs, error) {
+	registry, err := celtypes.NewRegistry()
+	if err != nil {
+		return nil, err
+	}
+
+	return &celStructs{
+		Registry: registry,
+		structs:  map[string]*celStruct{},
+		names:    map[types.Type]string{},
+	}, nil
+}
+
+// celType returns the CEL type of the Go type typ, declaring the structs it references. Pointers are
+// their elements, while types without a CEL equivalent, such as interfaces, are dynamic.
+func (p *celStructs) celType(typ types.Type) *celtypes.Type {
+	if typ == nil {
+		return celtypes.DynType
+	}
+
+	switch {
+	case isTimeType(typ):
+		return celtypes.TimestampType
+	case isDurationType(typ):
+		return celtypes.DurationType
+	}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		info := t.Info()
+
+		switch {
+		case info&types.IsBoolean != 0:
+			return celtypes.BoolType
+		case info&types.IsUnsigned != 0:
+			return celtypes.UintType
+		case info&types.IsInteger != 0:
+			return celtypes.IntType
+		case info&types.IsFloat != 0:
+			return celtypes.DoubleType
+		case info&types.IsString != 0:
+			return celtypes.StringType
+		}
+	case *types.Pointer:
+		return p.celType(t.Elem())
+	case *types.Slice:
+		if basic, ok := t.Elem().Underlying().(*types.Basic); ok && basic.Kind() == types.Byte {
+			return celtypes.BytesType
+		}
+
+		return celtypes.NewListType(p.celType(t.Elem()))
+	case *types.Array:
+		return celtypes.NewListType(p.celType(t.Elem()))
+	case *types.Map:
+		return celtypes.NewMapType(p.celType(t.Key()), p.celType(t.Elem()))
+	case *types.Struct:
+		return celtypes.NewObjectType(p.declare(typ, t))
+	}
+
+	return celtypes.DynType
+}
+
+// isDurationType reports whether typ is time.Duration.
+func isDurationType(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Duration"
+}
+
+// declare declares the struct st of type typ, returning its CEL type name: the qualified name of the
+// named type, or a generated name for struct literals.
+func (p *celStructs) declare(typ types.Type, st *types.Struct) string {
+	for declared, name := range p.names {
+		if types.Identical(declared, typ) {
+			return name
+		}
+	}
+
+	name := fmt.Sprintf("struct%d", len(p.structs))
+	if named, ok := types.Unalias(typ).(*types.Named); ok && named.Obj().Pkg() != nil {
+		name = named.Obj().Pkg().Name() + "." + named.Obj().Name()
+	}
+
+	s := &celStruct{fields: map[string]celField{}}
+
+	// The struct is declared before its fields, which may reference it
+	p.names[typ] = name
+	p.structs[name] = s
+
+	s.add(st, map[*types.Struct]bool{})
+
+	return name
+}
+
+// add adds the fields of st to s, then the fields promoted from its embedded structs that are not
+// shadowed, then the JSON names not clashing with a Go name.
+func (s *celStruct) add(st *types.Struct, seen map[*types.Struct]bool) {
+	if seen[st] {
+		return
+	}
+
+	seen[st] = true
+
+	var embedded []*types.Struct
+
+	for i := range st.NumFields() {
+		field := st.Field(i)
+
+		if _, ok := s.fields[field.Name()]; !ok {
+			s.fields[field.Name()] = celField{name: field.Name(), typ: field.Type()}
+			s.names = append(s.names, field.Name())
+		}
+
+		if embed, ok := derefStruct(field.Type()); ok && field.Embedded() {
+			embedded = append(embedded, embed)
+		}
+	}
+
+	for _, embed := range embedded {
+		s.add(embed, seen)
+	}
+
+	for i := range st.NumFields() {
+		name, _, _ := strings.Cut(reflect.StructTag(st.Tag(i)).Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+
+		if _, ok := s.fields[name]; !ok {
+			s.fields[name] = s.fields[st.Field(i).Name()]
+		}
+	}
+}
+
+// derefStruct returns the struct underlying typ or the element of the pointer typ.
+func derefStruct(typ types.Type) (*types.Struct, bool) {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	st, ok := typ.Underlying().(*types.Struct)
+
+	return st, ok
+}
+
+// field returns the field selected by name in the struct of the CEL type typ.
+func (p *celStructs) field(typ *celtypes.Type, name string) (celField, bool) {
+	if typ == nil || typ.Kind() != celtypes.StructKind {
+		return celField{}, false
+	}
+
+	s, ok := p.structs[typ.TypeName()]
+	if !ok {
+		return celField{}, false
+	}
+
+	field, ok := s.fields[name]
+
+	return field, ok
+}
+
+// FindStructType implements celtypes.Provider.
+func (p *celStructs) FindStructType(structType string) (*celtypes.Type, bool) {
+	if _, ok := p.structs[structType]; ok {
+		return celtypes.NewTypeTypeWithParam(celtypes.NewObjectType(structType)), true
+	}
+
+	return p.Registry.FindStructType(structType)
+}
+
+// FindStructFieldNames implements celtypes.Provider.
+func (p *celStructs) FindStructFieldNames(structType string) ([]string, bool) {
+	if s, ok := p.structs[structType]; ok {
+		return s.names, true
+	}
+
+	return p.Registry.FindStructFieldNames(structType)
+}
+
+// FindStructFieldType implements celtypes.Provider.
+func (p *celStructs) FindStructFieldType(structType, fieldName string) (*celtypes.FieldType, bool) {
+	s, ok := p.structs[structType]
+	if !ok {
+		return p.Registry.FindStructFieldType(structType, fieldName)
+	}
+
+	field, ok := s.fields[fieldName]
+	if !ok {
+		return nil, false
+	}
+
+	return &celtypes.FieldType{Type: p.celType(field.typ)}, true
+}
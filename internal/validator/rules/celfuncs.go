@@ -0,0 +1,456 @@
+package rules
+
+import (
+	"fmt"
+	"go/types"
+	"strings"
+
+	celtypes "github.com/google/cel-go/common/types"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// celTimestampAccessors are the Go expressions of the accessors of timestamps, in the location of the
+// time as cel-go does for Go times. Months and the days of the month and of the year start at 0 in CEL.
+var celTimestampAccessors = map[string]string{
+	"getFullYear":     "int64(%s.Year())",
+	"getMonth":        "(int64(%s.Month()) - 1)",
+	"getDate":         "int64(%s.Day())",
+	"getDayOfMonth":   "(int64(%s.Day()) - 1)",
+	"getDayOfYear":    "(int64(%s.YearDay()) - 1)",
+	"getDayOfWeek":    "int64(%s.Weekday())",
+	"getHours":        "int64(%s.Hour())",
+	"getMinutes":      "int64(%s.Minute())",
+	"getSeconds":      "int64(%s.Second())",
+	"getMilliseconds": "int64(%s.Nanosecond() / 1e6)",
+}
+
+// celDurationAccessors are the Go expressions of the accessors of durations, truncated to integers.
+var celDurationAccessors = map[string]string{
+	"getHours":        "int64(%s.Hours())",
+	"getMinutes":      "int64(%s.Minutes())",
+	"getSeconds":      "int64(%s.Seconds())",
+	"getMilliseconds": "%s.Milliseconds()",
+}
+
+// convertTimeAccessor converts the accessors of timestamps and durations, such as getFullYear.
+// The accessors taking a time zone are not supported.
+func (c *celValidator) convertTimeAccessor(function string, arg *exprpb.Expr, fieldName string) string {
+	accessors := celTimestampAccessors
+	if c.celKind(arg) == celtypes.DurationKind {
+		accessors = celDurationAccessors
+	} else if c.celKind(arg) != celtypes.TimestampKind {
+		return ""
+	}
+
+	accessor, ok := accessors[function]
+	if !ok {
+		return ""
+	}
+
+	return fmt.Sprintf(accessor, c.convertASTToGo(arg, fieldName))
+}
+
+// convertExtFunction converts the functions of the strings, lists, math and sets extensions of cel-go,
+// and the functions of optional values.
+func (c *celValidator) convertExtFunction(expr *exprpb.Expr, fieldName string) string {
+	call := expr.GetCallExpr()
+	args := callArgs(call)
+
+	switch {
+	case strings.HasPrefix(call.Function, "math."):
+		return c.convertMathFunction(expr, args, fieldName)
+	case strings.HasPrefix(call.Function, "sets."):
+		return c.convertSetsFunction(call.Function, args, fieldName)
+	case call.Function == "lists.range" && len(args) == 1:
+		return fmt.Sprintf("func(n int64) []int64 { var r []int64; for i := int64(0); i < n; i++ { r = append(r, i) }; return r }(%s)",
+			c.convertAsCEL(args[0], fieldName))
+	case call.Function == "strings.quote" && len(args) == 1:
+		c.use("strconv")
+
+		return fmt.Sprintf("strconv.Quote(%s)", c.convertString(args[0], fieldName))
+	case len(args) == 0:
+		return ""
+	case c.isOptional(args[0]):
+		return c.convertOptionalFunction(expr, args, fieldName)
+	case c.celKind(args[0]) == celtypes.StringKind:
+		return c.convertStringsFunction(call.Function, args, fieldName)
+	case c.celKind(args[0]) == celtypes.ListKind:
+		return c.convertListsFunction(expr, args, fieldName)
+	}
+
+	return ""
+}
+
+// convertStringsFunction converts the functions of the strings extension. Indexes count code points,
+// as CEL does, and the indexes out of range yield empty strings, where CEL fails.
+func (c *celValidator) convertStringsFunction(function string, args []*exprpb.Expr, fieldName string) string {
+	s := c.convertString(args[0], fieldName)
+
+	params := make([]string, len(args)-1)
+	for i, arg := range args[1:] {
+		params[i] = c.convertAsCEL(arg, fieldName)
+	}
+
+	switch {
+	case function == "charAt" && len(params) == 1:
+		return fmt.Sprintf("func(s string, i int64) string { r := []rune(s); if i < 0 || i >= int64(len(r)) { return \"\" }; return string(r[i]) }(%s, %s)",
+			s, params[0])
+	case function == "indexOf" && len(params) == 1:
+		c.use("strings", "unicode/utf8")
+
+		return fmt.Sprintf("func(s, sub string) int64 { i := strings.Index(s, sub); if i < 0 { return -1 }; return int64(utf8.RuneCountInString(s[:i])) }(%s, %s)",
+			s, params[0])
+	case function == "indexOf" && len(params) == 2:
+		c.use("strings", "unicode/utf8")
+
+		return fmt.Sprintf("func(s, sub string, start int64) int64 { r := []rune(s); if start < 0 || start > int64(len(r)) { return -1 }; "+
+			"s = string(r[start:]); i := strings.Index(s, sub); if i < 0 { return -1 }; return start + int64(utf8.RuneCountInString(s[:i])) }(%s, %s, %s)",
+			s, params[0], params[1])
+	case function == "lastIndexOf" && len(params) == 1:
+		c.use("strings", "unicode/utf8")
+
+		return fmt.Sprintf("func(s, sub string) int64 { i := strings.LastIndex(s, sub); if i < 0 { return -1 }; return int64(utf8.RuneCountInString(s[:i])) }(%s, %s)",
+			s, params[0])
+	case function == "lowerAscii" && len(params) == 0:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.Map(func(r rune) rune { if 'A' <= r && r <= 'Z' { return r + 'a' - 'A' }; return r }, %s)", s)
+	case function == "upperAscii" && len(params) == 0:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.Map(func(r rune) rune { if 'a' <= r && r <= 'z' { return r - 'a' + 'A' }; return r }, %s)", s)
+	case function == "replace" && len(params) == 2:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.ReplaceAll(%s, %s, %s)", s, params[0], params[1])
+	case function == "replace" && len(params) == 3:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.Replace(%s, %s, %s, int(%s))", s, params[0], params[1], params[2])
+	case function == "split" && len(params) == 1:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.Split(%s, %s)", s, params[0])
+	case function == "split" && len(params) == 2:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.SplitN(%s, %s, int(%s))", s, params[0], params[1])
+	case function == "substring" && len(params) == 1:
+		return fmt.Sprintf("func(s string, start int64) string { r := []rune(s); if start < 0 || start > int64(len(r)) { return \"\" }; return string(r[start:]) }(%s, %s)",
+			s, params[0])
+	case function == "substring" && len(params) == 2:
+		return fmt.Sprintf("func(s string, start, end int64) string { r := []rune(s); if start < 0 || end < start || end > int64(len(r)) { return \"\" }; return string(r[start:end]) }(%s, %s, %s)",
+			s, params[0], params[1])
+	case function == "trim" && len(params) == 0:
+		c.use("strings")
+
+		return fmt.Sprintf("strings.TrimSpace(%s)", s)
+	case function == "reverse" && len(params) == 0:
+		c.use("slices")
+
+		return fmt.Sprintf("func(s string) string { r := []rune(s); slices.Reverse(r); return string(r) }(%s)", s)
+	}
+
+	return ""
+}
+
+// convertListsFunction converts the functions of the lists extension, and join of the strings
+// extension. The functions return slices of the Go type of the list.
+func (c *celValidator) convertListsFunction(expr *exprpb.Expr, args []*exprpb.Expr, fieldName string) string {
+	function := expr.GetCallExpr().Function
+
+	if function == "join" && len(args) <= 2 {
+		list := c.convertAs(args[0], types.NewSlice(types.Typ[types.String]), fieldName)
+
+		separator := `""`
+		if len(args) == 2 {
+			separator = c.convertString(args[1], fieldName)
+		}
+
+		c.use("strings")
+
+		return fmt.Sprintf("strings.Join(%s, %s)", list, separator)
+	}
+
+	list := c.convertASTToGo(args[0], fieldName)
+	typ := c.goType(args[0])
+	listType := c.typeString(typ)
+
+	switch {
+	case function == "slice" && len(args) == 3:
+		c.goTypes[expr.GetId()] = typ
+
+		return fmt.Sprintf("func(l %s, start, end int64) %s { if start < 0 || end < start || end > int64(len(l)) { return nil }; return l[start:end] }(%s, %s, %s)",
+			listType, listType, list, c.convertAsCEL(args[1], fieldName), c.convertAsCEL(args[2], fieldName))
+	case function == "flatten" && len(args) == 1:
+		c.goTypes[expr.GetId()] = c.elemType(args[0])
+		c.use("slices")
+
+		return fmt.Sprintf("slices.Concat(%s...)", list)
+	case function == "distinct" && len(args) == 1:
+		c.goTypes[expr.GetId()] = typ
+		c.use("slices")
+
+		return fmt.Sprintf("func(l %s) %s { var r %s; for _, v := range l { if !slices.Contains(r, v) { r = append(r, v) } }; return r }(%s)",
+			listType, listType, listType, list)
+	case function == "reverse" && len(args) == 1:
+		c.goTypes[expr.GetId()] = typ
+		c.use("slices")
+
+		return fmt.Sprintf("func(l %s) %s { r := slices.Clone(l); slices.Reverse(r); return r }(%s)", listType, listType, list)
+	case function == "sort" && len(args) == 1:
+		// Timestamps and durations are not ordered in Go
+		if basic, ok := c.elemType(args[0]).Underlying().(*types.Basic); !ok || basic.Info()&types.IsOrdered == 0 {
+			return ""
+		}
+
+		c.goTypes[expr.GetId()] = typ
+		c.use("slices")
+
+		return fmt.Sprintf("func(l %s) %s { r := slices.Clone(l); slices.Sort(r); return r }(%s)", listType, listType, list)
+	}
+
+	return ""
+}
+
+// convertMathFunction converts the functions of the math extension. The arguments of math.greatest
+// and math.least must have the same CEL type.
+func (c *celValidator) convertMathFunction(expr *exprpb.Expr, args []*exprpb.Expr, fieldName string) string {
+	function := strings.TrimPrefix(expr.GetCallExpr().Function, "math.")
+
+	if function == "@max" || function == "@min" {
+		return c.convertMathExtremum(expr, args, fieldName)
+	}
+
+	if len(args) == 0 {
+		return ""
+	}
+
+	x := c.convertAsCEL(args[0], fieldName)
+	kind := c.celKind(args[0])
+
+	if len(args) == 2 {
+		y := c.convertAsCEL(args[1], fieldName)
+
+		switch function {
+		case "bitAnd":
+			return fmt.Sprintf("(%s & %s)", x, y)
+		case "bitOr":
+			return fmt.Sprintf("(%s | %s)", x, y)
+		case "bitXor":
+			return fmt.Sprintf("(%s ^ %s)", x, y)
+		case "bitShiftLeft":
+			return fmt.Sprintf("(%s << %s)", x, y)
+		case "bitShiftRight":
+			// Integers are shifted as unsigned integers
+			if kind == celtypes.IntKind {
+				return fmt.Sprintf("int64(uint64(%s) >> %s)", x, y)
+			}
+
+			return fmt.Sprintf("(%s >> %s)", x, y)
+		}
+
+		return ""
+	}
+
+	c.use("math")
+
+	switch function {
+	case "ceil", "floor", "round", "trunc":
+		return fmt.Sprintf("math.%s(%s)", strings.ToUpper(function[:1])+function[1:], x)
+	case "sqrt":
+		return fmt.Sprintf("math.Sqrt(float64(%s))", x)
+	case "isInf":
+		return fmt.Sprintf("math.IsInf(%s, 0)", x)
+	case "isNaN":
+		return fmt.Sprintf("math.IsNaN(%s)", x)
+	case "isFinite":
+		return fmt.Sprintf("func(x float64) bool { return !math.IsInf(x, 0) && !math.IsNaN(x) }(%s)", x)
+	case "bitNot":
+		return fmt.Sprintf("^%s", x)
+	case "abs":
+		switch kind {
+		case celtypes.DoubleKind:
+			return fmt.Sprintf("math.Abs(%s)", x)
+		case celtypes.UintKind:
+			return x
+		}
+
+		return fmt.Sprintf("func(x int64) int64 { if x < 0 { return -x }; return x }(%s)", x)
+	case "sign":
+		switch kind {
+		case celtypes.DoubleKind:
+			return fmt.Sprintf("func(x float64) float64 { switch { case x > 0: return 1; case x < 0: return -1 }; return x }(%s)", x)
+		case celtypes.UintKind:
+			return fmt.Sprintf("min(%s, 1)", x)
+		}
+
+		return fmt.Sprintf("func(x int64) int64 { switch { case x > 0: return 1; case x < 0: return -1 }; return 0 }(%s)", x)
+	}
+
+	return ""
+}
+
+// convertMathExtremum converts math.greatest and math.least, whose arguments are a list, a single value,
+// or values passed in a list literal when more than two, to slices.Max and slices.Min, or to the max
+// and min built-in functions.
+func (c *celValidator) convertMathExtremum(expr *exprpb.Expr, args []*exprpb.Expr, fieldName string) string {
+	builtin := strings.TrimPrefix(expr.GetCallExpr().Function, "math.@")
+
+	if len(args) == 1 {
+		if args[0].GetListExpr() == nil {
+			if c.celKind(args[0]) != celtypes.ListKind {
+				return c.convertAsCEL(args[0], fieldName)
+			}
+
+			c.goTypes[expr.GetId()] = c.elemType(args[0])
+			c.use("slices")
+
+			return fmt.Sprintf("slices.%s(%s)", strings.ToUpper(builtin[:1])+builtin[1:], c.convertASTToGo(args[0], fieldName))
+		}
+
+		args = args[0].GetListExpr().GetElements()
+	}
+
+	values := make([]string, len(args))
+	for i, arg := range args {
+		if c.celKind(arg) != c.celKind(args[0]) {
+			return ""
+		}
+
+		values[i] = c.convertAsCEL(arg, fieldName)
+	}
+
+	return fmt.Sprintf("%s(%s)", builtin, strings.Join(values, ", "))
+}
+
+// convertSetsFunction converts the functions of the sets extension, testing the membership of the
+// elements of the lists with slices.Contains. Literal lists take the Go type of the other list.
+func (c *celValidator) convertSetsFunction(function string, args []*exprpb.Expr, fieldName string) string {
+	if len(args) != 2 {
+		return ""
+	}
+
+	typ := c.goType(args[0])
+	if args[0].GetListExpr() != nil {
+		typ = c.goType(args[1])
+	}
+
+	a := c.convertCollectionAs(args[0], typ, fieldName)
+	b := c.convertCollectionAs(args[1], typ, fieldName)
+	listType := c.typeString(typ)
+
+	c.use("slices")
+
+	switch function {
+	case "sets.contains":
+		return fmt.Sprintf("func(a, b %s) bool { for _, v := range b { if !slices.Contains(a, v) { return false } }; return true }(%s, %s)",
+			listType, a, b)
+	case "sets.intersects":
+		return fmt.Sprintf("func(a, b %s) bool { for _, v := range b { if slices.Contains(a, v) { return true } }; return false }(%s, %s)",
+			listType, a, b)
+	case "sets.equivalent":
+		return fmt.Sprintf("func(a, b %s) bool { for _, v := range a { if !slices.Contains(b, v) { return false } }; "+
+			"for _, v := range b { if !slices.Contains(a, v) { return false } }; return true }(%s, %s)",
+			listType, a, b)
+	}
+
+	return ""
+}
+
+// isOptional reports whether the checked expression is an optional value.
+func (c *celValidator) isOptional(expr *exprpb.Expr) bool {
+	typ := c.checked[expr.GetId()]
+
+	return typ != nil && typ.Kind() == celtypes.OpaqueKind && typ.TypeName() == "optional_type"
+}
+
+// convertOptionalFunction converts the functions consuming optional values: hasValue, value and orValue.
+func (c *celValidator) convertOptionalFunction(expr *exprpb.Expr, args []*exprpb.Expr, fieldName string) string {
+	function := expr.GetCallExpr().Function
+
+	value, present, typ := c.convertOptional(args[0], fieldName)
+
+	switch {
+	case function == "hasValue" && len(args) == 1:
+		return present
+	case function == "value" && len(args) == 1:
+		c.goTypes[expr.GetId()] = typ
+
+		return value
+	case function == "orValue" && len(args) == 2:
+		c.goTypes[expr.GetId()] = typ
+
+		return fmt.Sprintf("func() %s { if %s { return %s }; return %s }()",
+			c.typeString(typ), present, value, c.convertAs(args[1], typ, fieldName))
+	}
+
+	return ""
+}
+
+// convertOptional converts the optional value expr, returning the Go expressions of its value, only
+// evaluated when present, and of its presence, and the Go type of its value. The optional values are
+// the selections of optional fields of structs, present when set as in proto3, and of optional keys of
+// maps and indexes of lists, the values created by the optional functions, the first and last elements
+// of lists and the alternatives of optional values.
+func (c *celValidator) convertOptional(expr *exprpb.Expr, fieldName string) (string, string, types.Type) {
+	call := expr.GetCallExpr()
+	if call == nil {
+		return c.unsupported("optional value"), trueFallback, anyType
+	}
+
+	args := callArgs(call)
+
+	switch {
+	case (call.Function == "_?._" || call.Function == "_[?_]") && len(args) == 2:
+		if c.isOptional(args[0]) {
+			break
+		}
+
+		operand := c.convertASTToGo(args[0], fieldName)
+
+		switch t := c.goType(args[0]).Underlying().(type) {
+		case *types.Map:
+			value := fmt.Sprintf("%s[%s]", operand, c.convertAs(args[1], t.Key(), fieldName))
+
+			return value, fmt.Sprintf("func() bool { _, ok := %s; return ok }()", value), t.Elem()
+		case *types.Slice:
+			index := c.convertAsCEL(args[1], fieldName)
+
+			return fmt.Sprintf("%s[%s]", operand, index), fmt.Sprintf("(%s >= 0 && %s < int64(len(%s)))", index, index, operand), t.Elem()
+		}
+
+		if field, ok := c.structs.field(c.checked[args[0].GetId()], args[1].GetConstExpr().GetStringValue()); ok {
+			value := fmt.Sprintf("%s.%s", operand, field.name)
+
+			return value, c.isSet(value, field.typ), field.typ
+		}
+	case call.Function == "optional.of" && len(args) == 1:
+		return c.convertASTToGo(args[0], fieldName), "true", c.goType(args[0])
+	case call.Function == "optional.ofNonZeroValue" && len(args) == 1:
+		value := c.convertASTToGo(args[0], fieldName)
+
+		return value, c.isSet(value, c.goType(args[0])), c.goType(args[0])
+	case call.Function == "optional.none" && len(args) == 0:
+		typ := c.goTypeOfCEL(c.checked[expr.GetId()].Parameters()[0])
+
+		return fmt.Sprintf("*new(%s)", c.typeString(typ)), "false", typ
+	case (call.Function == "first" || call.Function == "last") && len(args) == 1 && c.celKind(args[0]) == celtypes.ListKind:
+		list := c.convertASTToGo(args[0], fieldName)
+
+		index := "0"
+		if call.Function == "last" {
+			index = fmt.Sprintf("len(%s)-1", list)
+		}
+
+		return fmt.Sprintf("%s[%s]", list, index), fmt.Sprintf("len(%s) > 0", list), c.elemType(args[0])
+	case call.Function == "or" && len(args) == 2 && c.isOptional(args[1]):
+		value, present, typ := c.convertOptional(args[0], fieldName)
+		otherValue, otherPresent, _ := c.convertOptional(args[1], fieldName)
+
+		return fmt.Sprintf("func() %s { if %s { return %s }; return %s }()", c.typeString(typ), present, value, otherValue),
+			fmt.Sprintf("(%s) || (%s)", present, otherPresent), typ
+	}
+
+	return c.unsupported("optional function " + strings.TrimPrefix(call.Function, "@")), trueFallback, anyType
+}
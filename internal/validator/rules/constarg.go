@@ -0,0 +1,230 @@
+package rules
+
+import (
+	"go/constant"
+	"go/token"
+	"go/types"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// constArg is the argument of a marker, either a literal or a reference to a Go constant, such as
+// maxlength=MaxNameLen or oneof=region.Default. References are emitted as is, so the generated code
+// follows the constant, while the error messages show the value of the constant.
+type constArg struct {
+	// expr is the argument in the generated code, qualified by the name of the package of the constant.
+	expr string
+	// value is the argument in the error messages, written as a Go literal.
+	value string
+	// imports are the packages of the referenced constant.
+	imports []string
+}
+
+// constIdent matches the identifiers and qualified identifiers that may name a constant.
+var constIdent = regexp.MustCompile(`^[A-Za-z_]\w*(\.[A-Za-z_]\w*)?$`)
+
+// literalArg returns the argument raw, kept as written in the marker.
+func literalArg(raw string) constArg {
+	return constArg{expr: raw, value: raw}
+}
+
+// lookupConst resolves raw as a constant, or an exported constant of an imported package, in the
+// scope of the file declaring the marker: the file of the field, or of the named type the field
+// inherits the marker from, see constScope. It returns false when raw does not name a constant.
+func lookupConst(input registry.ValidatorInput, raw string) (constArg, *types.Const, bool) {
+	if !constIdent.MatchString(raw) {
+		return constArg{}, nil, false
+	}
+
+	scope, pos := constScope(input)
+
+	qualifier, name, qualified := strings.Cut(raw, ".")
+	if !qualified {
+		c, ok := lookupParent(scope, raw, pos).(*types.Const)
+		if !ok {
+			return constArg{}, nil, false
+		}
+
+		return constReference(input, c), c, true
+	}
+
+	pkg := lookupPackage(input, scope, qualifier, pos)
+	if pkg == nil {
+		return constArg{}, nil, false
+	}
+
+	c, ok := pkg.Scope().Lookup(name).(*types.Const)
+	if !ok || (!c.Exported() && pkg != markerPackage(input)) {
+		return constArg{}, nil, false
+	}
+
+	return constReference(input, c), c, true
+}
+
+// constScope returns the scope in which the constants of the marker resolve, with the position of
+// the marker in it. The markers inherited from a named type of another package resolve in the scope
+// of that package, whose files are not available.
+func constScope(input registry.ValidatorInput) (*types.Scope, token.Pos) {
+	if pkg := declaringPackage(input); pkg != nil {
+		return pkg.Scope(), token.NoPos
+	}
+
+	pos := input.Field.Pos()
+	if input.DeclaredBy != nil {
+		pos = input.DeclaredBy.Pos()
+	}
+
+	scope := input.Pass.Pkg.Scope().Innermost(pos)
+	if scope == nil {
+		scope = input.Pass.Pkg.Scope()
+	}
+
+	return scope, pos
+}
+
+// lookupPackage returns the package named qualifier in scope, or nil. The package declaring the
+// marker is named by its own name, unless an import shadows it. The imports of the files of another
+// package are not known, so a qualifier of such a scope names one of its imports.
+func lookupPackage(input registry.ValidatorInput, scope *types.Scope, qualifier string, pos token.Pos) *types.Package {
+	if pkgName, ok := lookupParent(scope, qualifier, pos).(*types.PkgName); ok {
+		return pkgName.Imported()
+	}
+
+	if pkg := markerPackage(input); pkg.Name() == qualifier {
+		return pkg
+	}
+
+	pkg := declaringPackage(input)
+	if pkg == nil {
+		return nil
+	}
+
+	for _, imported := range pkg.Imports() {
+		if imported.Name() == qualifier {
+			return imported
+		}
+	}
+
+	return nil
+}
+
+// markerPackage returns the package declaring the marker: the analyzed package, or the package of
+// the named type the field inherits the marker from.
+func markerPackage(input registry.ValidatorInput) *types.Package {
+	if pkg := declaringPackage(input); pkg != nil {
+		return pkg
+	}
+
+	return input.Pass.Pkg
+}
+
+// declaringPackage returns the package of the named type declaring the marker when it is not the
+// analyzed package, or nil.
+func declaringPackage(input registry.ValidatorInput) *types.Package {
+	if input.DeclaredBy == nil || input.DeclaredBy.Pkg() == input.Pass.Pkg {
+		return nil
+	}
+
+	return input.DeclaredBy.Pkg()
+}
+
+// constReference returns the argument referencing the constant c in the generated code. The constants
+// of other packages are qualified by the name of their package, which the generated file imports
+// under that name whatever the alias of the field's file, or written as their value when they are
+// not exported.
+func constReference(input registry.ValidatorInput, c *types.Const) constArg {
+	value := constValue(c.Val())
+
+	if c.Pkg() == nil || c.Pkg() == input.Pass.Pkg {
+		return constArg{expr: c.Name(), value: value}
+	}
+
+	if !c.Exported() {
+		return constArg{expr: value, value: value}
+	}
+
+	return constArg{expr: c.Pkg().Name() + "." + c.Name(), value: value, imports: []string{c.Pkg().Path()}}
+}
+
+// lookupParent returns the object named name in scope or its parents, or nil.
+func lookupParent(scope *types.Scope, name string, pos token.Pos) types.Object {
+	_, obj := scope.LookupParent(name, pos)
+
+	return obj
+}
+
+// constValue returns the value of a constant written as a Go literal.
+func constValue(value constant.Value) string {
+	if value.Kind() == constant.Float {
+		// ExactString writes fractions, such as 1/2
+		return value.String()
+	}
+
+	return value.ExactString()
+}
+
+// constantArg returns the argument raw of a rule comparing it with a value of type typ. Literals are
+// kept as written, while identifiers must name a constant assignable to typ, which is reported
+// otherwise, returning false.
+func constantArg(input registry.ValidatorInput, raw string, typ types.Type) (constArg, bool) {
+	if !constIdent.MatchString(raw) {
+		return literalArg(raw), true
+	}
+
+	arg, c, ok := lookupConst(input, raw)
+	if !ok {
+		input.Report(input.Field.Pos(), "%s: %s is not a constant", input.RuleName, raw)
+
+		return constArg{}, false
+	}
+
+	if !constAssignable(c, typ) {
+		input.Report(input.Field.Pos(), "%s: constant %s of type %s cannot be used with %s",
+			input.RuleName, raw, c.Type(), typ)
+
+		return constArg{}, false
+	}
+
+	return arg, true
+}
+
+// constAssignable reports whether the constant c can be compared with a value of type typ.
+func constAssignable(c *types.Const, typ types.Type) bool {
+	return types.AssignableTo(c.Type(), typ) && representable(c.Val(), typ)
+}
+
+// stringArg returns the argument raw of a rule comparing it with a string of type typ. A bare word is
+// always a string, quoted for the generated code, so that oneof=admin user keeps its meaning whatever
+// the constants of the package. Constants are named by qualified names, such as region.Default, or
+// app.RoleAdmin for the constants of the package app, and a qualified name that does not name a
+// constant assignable to typ is a string too, such as example.com.
+func stringArg(input registry.ValidatorInput, raw string, typ types.Type) constArg {
+	if strings.HasPrefix(raw, `"`) || strings.HasPrefix(raw, "`") {
+		return literalArg(raw)
+	}
+
+	if !strings.Contains(raw, ".") {
+		return literalArg(strconv.Quote(raw))
+	}
+
+	if arg, c, ok := lookupConst(input, raw); ok && constAssignable(c, typ) {
+		return arg
+	}
+
+	return literalArg(strconv.Quote(raw))
+}
+
+// param returns the argument in a list of values of an error message, where strings are not quoted.
+// The escapes of the string are kept, as the messages are string literals of the generated code.
+func (a constArg) param() string {
+	if s, err := strconv.Unquote(a.value); err == nil {
+		quoted := strconv.Quote(s)
+
+		return quoted[1 : len(quoted)-1]
+	}
+
+	return a.value
+}
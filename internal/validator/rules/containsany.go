@@ -28,8 +28,7 @@ var _ validator.Validator = (*containsanyValidator)(nil)
 const containsanyKey = "%s-containsany"
 
 func (c *containsanyValidator) Validate() string {
-	fieldName := c.FieldName()
-	return fmt.Sprintf("!strings.ContainsAny(t.%s, %q)", fieldName, c.chars)
+	return fmt.Sprintf("!strings.ContainsAny(%s, %q)", stringField(c.pass, c.field), c.chars)
 }
 
 func (c *containsanyValidator) FieldName() string {
@@ -58,7 +57,7 @@ func (c *containsanyValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field does not contain any of the specified characters.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must contain at least one of these characters: [@CHARS]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must contain at least one of these characters: [@CHARS]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@CHARS]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sContainsanyValidation", c.structName, c.FieldName())
@@ -27,9 +27,8 @@ var _ validator.Validator = (*dateValidator)(nil)
 const dateKey = "%s-date"
 
 func (d *dateValidator) Validate() string {
-	fieldName := d.FieldName()
 	// use helper for pattern check
-	return fmt.Sprintf("!validationhelper.IsValidDateDDMMYY(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidDateDDMMYY(%s)", stringField(d.pass, d.field))
 }
 
 func (d *dateValidator) FieldName() string { return d.field.Names[0].Name }
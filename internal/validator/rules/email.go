@@ -26,9 +26,8 @@ var _ validator.Validator = (*emailValidator)(nil)
 const emailKey = "%s-email"
 
 func (e *emailValidator) Validate() string {
-	fieldName := e.FieldName()
 	// Use external helper function for better maintainability
-	return fmt.Sprintf("!validationhelper.IsValidEmail(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidEmail(%s)", stringField(e.pass, e.field))
 }
 
 func (e *emailValidator) FieldName() string {
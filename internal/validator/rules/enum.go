@@ -2,9 +2,12 @@
 package rules
 
 import (
+	"cmp"
 	"fmt"
 	"go/ast"
+	"go/constant"
 	"go/types"
+	"slices"
 	"strings"
 
 	"github.com/gostaticanalysis/codegen"
@@ -18,6 +21,12 @@ type enumValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
 	enumValues []string
+	// constants are the Go constants of the named type of the field, when the marker lists no values,
+	// and imports the packages they are declared in.
+	constants []string
+	imports   []string
+	// values are the values listed by the marker, quoted for string and custom types unless they are the qualified name of a constant.
+	values     []constArg
 	isString   bool
 	isNumeric  bool
 	isCustom   bool
@@ -33,16 +42,15 @@ const enumKey = "%s-enum"
 func (e *enumValidator) Validate() string {
 	fieldName := e.FieldName()
 
+	if len(e.constants) > 0 {
+		return fmt.Sprintf("func() bool { switch t.%s { case %s: return false }; return true }()",
+			fieldName, strings.Join(e.constants, ", "))
+	}
+
 	var conditions []string
 
-	for _, value := range e.enumValues {
-		if e.isString || e.isCustom {
-			// For string and custom types, use quoted comparison
-			conditions = append(conditions, fmt.Sprintf("t.%s != %q", fieldName, value))
-		} else if e.isNumeric {
-			// For numeric types, use unquoted comparison
-			conditions = append(conditions, fmt.Sprintf("t.%s != %s", fieldName, value))
-		}
+	for _, value := range e.values {
+		conditions = append(conditions, fmt.Sprintf("t.%s != %s", fieldName, value.expr))
 	}
 
 	return strings.Join(conditions, " && ")
@@ -76,7 +84,7 @@ func (e *enumValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the value is not in the allowed enum values [@ENUM_LIST].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be one of [@ENUM_LIST]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be one of [@ENUM_LIST]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@ENUM_LIST]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sEnumValidation", e.structName, e.FieldName())
@@ -103,16 +111,18 @@ func (e *enumValidator) ErrVariable() string {
 }
 
 func (e *enumValidator) Imports() []string {
-	return []string{}
+	return e.imports
 }
 
 // ValidateEnum creates a new enumValidator for string, numeric, and custom types.
+// Without values, e.g., +govalid:enum, the field must be one of the constants of its named type,
+// which are collected from the package declaring the type.
 func ValidateEnum(input registry.ValidatorInput) validator.Validator {
 	typ := input.Pass.TypesInfo.TypeOf(input.Field.Type)
 
 	enumValue, ok := input.Expressions[markers.GoValidMarkerEnum]
-	if !ok {
-		return nil
+	if !ok || strings.TrimSpace(enumValue) == "" {
+		return enumConstants(input, typ)
 	}
 
 	enumValues := strings.Split(enumValue, ",")
@@ -154,5 +164,98 @@ func ValidateEnum(input registry.ValidatorInput) validator.Validator {
 		validator.isCustom = true
 	}
 
+	for _, v := range enumValues {
+		if validator.isNumeric {
+			arg, ok := constantArg(input, v, typ)
+			if !ok {
+				return nil
+			}
+
+			validator.values = append(validator.values, arg)
+		} else {
+			validator.values = append(validator.values, stringArg(input, v, typ))
+		}
+	}
+
+	for i, v := range validator.values {
+		validator.enumValues[i] = v.param()
+		validator.imports = appendImports(validator.imports, v.imports...)
+	}
+
 	return validator
 }
+
+// enumConstants creates an enumValidator for the constants of the named type typ, reporting a
+// diagnostic and returning nil when typ is not a named type or has no constants.
+func enumConstants(input registry.ValidatorInput, typ types.Type) validator.Validator {
+	named, ok := types.Unalias(typ).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		input.Report(input.Field.Pos(), "%s: %s of type %s must list its values or have a named type with constants",
+			input.RuleName, input.Field.Names[0].Name, typ)
+
+		return nil
+	}
+
+	pkg := named.Obj().Pkg()
+	local := pkg == input.Pass.Pkg
+
+	e := &enumValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+
+	var consts []*types.Const
+
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if ok && types.Identical(c.Type(), named) && (local || c.Exported()) {
+			consts = append(consts, c)
+		}
+	}
+
+	// Keep the declaration order, so the first of constants sharing a value wins
+	slices.SortStableFunc(consts, func(a, b *types.Const) int {
+		return cmp.Compare(a.Pos(), b.Pos())
+	})
+
+	seen := map[string]bool{}
+
+	for _, c := range consts {
+		name := c.Name()
+
+		// Constants with the same value, such as aliases of a default, would be duplicate cases
+		value := c.Val().ExactString()
+		if seen[value] {
+			continue
+		}
+
+		seen[value] = true
+
+		if c.Val().Kind() == constant.String {
+			value = constant.StringVal(c.Val())
+		}
+
+		e.enumValues = append(e.enumValues, value)
+
+		if local {
+			e.constants = append(e.constants, name)
+		} else {
+			e.constants = append(e.constants, pkg.Name()+"."+name)
+		}
+	}
+
+	if len(e.constants) == 0 {
+		input.Report(input.Field.Pos(), "%s: type %s has no constants", input.RuleName, typ)
+
+		return nil
+	}
+
+	if !local {
+		e.imports = []string{pkg.Path()}
+	}
+
+	return e
+}
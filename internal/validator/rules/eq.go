@@ -17,7 +17,7 @@ import (
 type eqValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	eqValue    string
+	eqValue    constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*eqValidator)(nil)
 const eqKey = "%s-eq"
 
 func (e *eqValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s == %s)", e.FieldName(), e.eqValue)
+	return fmt.Sprintf("!(t.%s == %s)", e.FieldName(), e.eqValue.expr)
 }
 
 func (e *eqValidator) FieldName() string {
@@ -57,14 +57,14 @@ func (e *eqValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field does not equal [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must equal [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must equal [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sEqValidation", e.structName, e.FieldName())
 	currentErrVarName := e.ErrVariable()
 
 	// Escape quotes in the value for error message
-	escapedValue := strings.ReplaceAll(e.eqValue, `"`, `\"`)
+	escapedValue := strings.ReplaceAll(e.eqValue.value, `"`, `\"`)
 
 	replacer := strings.NewReplacer(
 		"[@ERRVARIABLE]", currentErrVarName,
@@ -87,7 +87,7 @@ func (e *eqValidator) ErrVariable() string {
 }
 
 func (e *eqValidator) Imports() []string {
-	return []string{}
+	return e.eqValue.imports
 }
 
 // ValidateEq creates a new eqValidator if the field is comparable and the eq marker is present.
@@ -104,17 +104,19 @@ func ValidateEq(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
-	// For string types, wrap the value in quotes if not already quoted
+	// For string types, wrap the value in quotes if not already quoted, unless it is the qualified name of a constant
+	var value constArg
+
 	if basic, ok := typ.Underlying().(*types.Basic); ok && basic.Kind() == types.String {
-		if !strings.HasPrefix(eqValue, `"`) && !strings.HasPrefix(eqValue, "`") {
-			eqValue = fmt.Sprintf(`"%s"`, eqValue)
-		}
+		value = stringArg(input, eqValue, typ)
+	} else if value, ok = constantArg(input, eqValue, typ); !ok {
+		return nil
 	}
 
 	return &eqValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		eqValue:    eqValue,
+		eqValue:    value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
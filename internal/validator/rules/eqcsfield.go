@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type eqcsfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*eqcsfieldValidator)(nil)
+
+const eqcsfieldKey = "%s-eqcsfield"
+
+func (v *eqcsfieldValidator) Err() string {
+	return v.err(eqcsfieldKey, v.ErrVariable())
+}
+
+func (v *eqcsfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]EqCSFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateEqCSField creates a new eqcsfieldValidator for the given field.
+// The field must be equal to the field at a path from the validated struct, e.g., eqcsfield=Account.Email.
+func ValidateEqCSField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerEqcsfield, compareEq, true)
+	if comparison == nil {
+		return nil
+	}
+
+	return &eqcsfieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type eqfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*eqfieldValidator)(nil)
+
+const eqfieldKey = "%s-eqfield"
+
+func (v *eqfieldValidator) Err() string {
+	return v.err(eqfieldKey, v.ErrVariable())
+}
+
+func (v *eqfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]EqFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateEqField creates a new eqfieldValidator for the given field.
+// The field must be equal to another field of the same struct, e.g., eqfield=Password.
+func ValidateEqField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerEqfield, compareEq, false)
+	if comparison == nil {
+		return nil
+	}
+
+	return &eqfieldValidator{fieldComparison: comparison}
+}
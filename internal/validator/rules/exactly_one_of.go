@@ -0,0 +1,44 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type exactly_one_ofValidator struct {
+	*fieldGroup
+}
+
+var _ validator.DependentValidator = (*exactly_one_ofValidator)(nil)
+
+const exactly_one_ofKey = "%s-exactly_one_of"
+
+func (v *exactly_one_ofValidator) Validate() string {
+	return v.count() + " != 1"
+}
+
+func (v *exactly_one_ofValidator) Err() string {
+	return v.err(exactly_one_ofKey, v.ErrVariable(), "exactly one of [@FIELDS] must be set")
+}
+
+func (v *exactly_one_ofValidator) ErrVariable() string {
+	return v.errVariable("ExactlyOneOf")
+}
+
+func (v *exactly_one_ofValidator) Imports() []string {
+	return append([]string{"github.com/templatedop/govalid/validation/validationhelper"}, v.fieldGroup.Imports()...)
+}
+
+// ValidateExactlyOneOf creates a new exactly_one_ofValidator for the struct type declaring the marker,
+// e.g., +govalid:exactly_one_of=CardToken BankAccount Wallet.
+// Exactly one of the fields must be set.
+func ValidateExactlyOneOf(input registry.ValidatorInput) validator.Validator {
+	group := newFieldGroup(input, markers.GoValidMarkerExactly_one_of)
+	if group == nil {
+		return nil
+	}
+
+	return &exactly_one_ofValidator{fieldGroup: group}
+}
@@ -14,16 +14,15 @@ import (
 )
 
 type excluded_unlessValidator struct {
-	pass          *codegen.Pass
-	field         *ast.Field
-	otherField    string
-	expectedValue string
-	structName    string
-	ruleName      string
-	parentPath    string
+	pass       *codegen.Pass
+	field      *ast.Field
+	conditions fieldConditions
+	structName string
+	ruleName   string
+	parentPath string
 }
 
-var _ validator.Validator = (*excluded_unlessValidator)(nil)
+var _ validator.DependentValidator = (*excluded_unlessValidator)(nil)
 
 const excluded_unlessKey = "%s-excluded_unless"
 
@@ -32,9 +31,7 @@ func (e *excluded_unlessValidator) Validate() string {
 	zero := getZeroValueForType(typ)
 	fieldName := e.FieldName()
 
-	// Generate: if otherField != expectedValue && thisField != zeroValue { fail }
-	return fmt.Sprintf("t.%s != %s && t.%s != %s",
-		e.otherField, e.expectedValue, fieldName, zero)
+	return fmt.Sprintf("%s && t.%s != %s", e.conditions.NotMet(), fieldName, zero)
 }
 
 func (e *excluded_unlessValidator) FieldName() string {
@@ -63,22 +60,19 @@ func (e *excluded_unlessValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field must be absent unless another field has a specific value.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent unless [@OTHER] equals [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent unless [@CONDITIONS]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sExcludedUnlessValidation", e.structName, e.FieldName())
 	currentErrVarName := e.ErrVariable()
 
-	// Escape quotes in the value for error message
-	escapedValue := strings.ReplaceAll(e.expectedValue, `"`, `\"`)
-
 	replacer := strings.NewReplacer(
 		"[@ERRVARIABLE]", currentErrVarName,
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", e.FieldName(),
 		"[@PATH]", e.FieldPath().String(),
-		"[@OTHER]", e.otherField,
-		"[@VALUE]", escapedValue,
+		"[@CONDITIONS]", e.conditions.Describe(),
+		"[@PARAM]", e.conditions.Param(),
 		"[@TYPE]", e.ruleName,
 	)
 
@@ -94,36 +88,33 @@ func (e *excluded_unlessValidator) ErrVariable() string {
 }
 
 func (e *excluded_unlessValidator) Imports() []string {
-	return []string{}
+	return e.conditions.Imports()
+}
+
+// DependsOn implements validator.DependentValidator.
+func (e *excluded_unlessValidator) DependsOn() []string {
+	return e.conditions.Fields()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (e *excluded_unlessValidator) UsesRoot() bool {
+	return e.conditions.UsesRoot()
 }
 
 // ValidateExcludedUnless creates a new excluded_unlessValidator.
-// Format: excluded_unless=OtherField Value
+// Format: excluded_unless=OtherField Value [OtherField Value ...], all the pairs being ANDed
 func ValidateExcludedUnless(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerExcluded_unless]
-	if !ok {
+	conditions := parseFieldConditions(input, markers.GoValidMarkerExcluded_unless)
+	if conditions == nil {
 		return nil
 	}
 
-	parts := strings.Fields(expr)
-	if len(parts) < 2 {
-		return nil
-	}
-
-	otherField := parts[0]
-	expectedValue := strings.Join(parts[1:], " ")
-
-	if !strings.HasPrefix(expectedValue, `"`) && !strings.HasPrefix(expectedValue, "`") {
-		expectedValue = fmt.Sprintf(`"%s"`, expectedValue)
-	}
-
 	return &excluded_unlessValidator{
-		pass:          input.Pass,
-		field:         input.Field,
-		otherField:    otherField,
-		expectedValue: expectedValue,
-		structName:    input.StructName,
-		ruleName:      input.RuleName,
-		parentPath:    input.ParentPath,
+		pass:       input.Pass,
+		field:      input.Field,
+		conditions: conditions,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
 	}
 }
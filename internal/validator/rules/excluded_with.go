@@ -16,29 +16,27 @@ import (
 type excluded_withValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	fields     []string
+	fields     fieldRefs
 	structName string
 	ruleName   string
 	parentPath string
 }
 
-var _ validator.Validator = (*excluded_withValidator)(nil)
+var _ validator.DependentValidator = (*excluded_withValidator)(nil)
 
 const excluded_withKey = "%s-excluded_with"
 
 func (e *excluded_withValidator) Validate() string {
 	typ := e.pass.TypesInfo.TypeOf(e.field.Type)
 	zero := getZeroValueForType(typ)
-	fieldName := e.FieldName()
+	condition, _ := e.condition()
 
-	// Generate: (field1 != zero || field2 != zero || ...) && thisField != zero
-	var conditions []string
-	for _, f := range e.fields {
-		conditions = append(conditions, fmt.Sprintf(`t.%s != ""`, f))
-	}
+	return fmt.Sprintf("%s && t.%s != %s", condition, e.FieldName(), zero)
+}
 
-	return fmt.Sprintf("(%s) && t.%s != %s",
-		strings.Join(conditions, " || "), fieldName, zero)
+// condition returns the condition on the other fields under which the rule applies, and its imports.
+func (e *excluded_withValidator) condition() (string, []string) {
+	return e.fields.Any(true)
 }
 
 func (e *excluded_withValidator) FieldName() string {
@@ -67,7 +65,7 @@ func (e *excluded_withValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field must be absent because other fields are present.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent when any of [@FIELDS] are present", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent when any of [@FIELDS] are present", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sExcludedWithValidation", e.structName, e.FieldName())
@@ -78,7 +76,8 @@ func (e *excluded_withValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", e.FieldName(),
 		"[@PATH]", e.FieldPath().String(),
-		"[@FIELDS]", strings.Join(e.fields, ", "),
+		"[@FIELDS]", strings.Join(e.fields.Paths(), ", "),
+		"[@PARAM]", e.fields.Param(),
 		"[@TYPE]", e.ruleName,
 	)
 
@@ -94,19 +93,26 @@ func (e *excluded_withValidator) ErrVariable() string {
 }
 
 func (e *excluded_withValidator) Imports() []string {
-	return []string{}
+	_, imports := e.condition()
+
+	return imports
+}
+
+// DependsOn implements validator.DependentValidator.
+func (e *excluded_withValidator) DependsOn() []string {
+	return e.fields.Paths()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (e *excluded_withValidator) UsesRoot() bool {
+	return e.fields.UsesRoot()
 }
 
 // ValidateExcludedWith creates a new excluded_withValidator.
 // Format: excluded_with=Field1 Field2 Field3...
 func ValidateExcludedWith(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerExcluded_with]
-	if !ok {
-		return nil
-	}
-
-	fields := strings.Fields(expr)
-	if len(fields) == 0 {
+	fields := parseFieldRefs(input, markers.GoValidMarkerExcluded_with)
+	if fields == nil {
 		return nil
 	}
 
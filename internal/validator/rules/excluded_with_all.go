@@ -16,28 +16,27 @@ import (
 type excluded_with_allValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	fields     []string
+	fields     fieldRefs
 	structName string
 	ruleName   string
 	parentPath string
 }
 
-var _ validator.Validator = (*excluded_with_allValidator)(nil)
+var _ validator.DependentValidator = (*excluded_with_allValidator)(nil)
 
 const excluded_with_allKey = "%s-excluded_with_all"
 
 func (e *excluded_with_allValidator) Validate() string {
 	typ := e.pass.TypesInfo.TypeOf(e.field.Type)
 	zero := getZeroValueForType(typ)
-	fieldName := e.FieldName()
+	condition, _ := e.condition()
 
-	var conditions []string
-	for _, f := range e.fields {
-		conditions = append(conditions, fmt.Sprintf(`t.%s != ""`, f))
-	}
+	return fmt.Sprintf("%s && t.%s != %s", condition, e.FieldName(), zero)
+}
 
-	return fmt.Sprintf("(%s) && t.%s != %s",
-		strings.Join(conditions, " && "), fieldName, zero)
+// condition returns the condition on the other fields under which the rule applies, and its imports.
+func (e *excluded_with_allValidator) condition() (string, []string) {
+	return e.fields.All(true)
 }
 
 func (e *excluded_with_allValidator) FieldName() string {
@@ -66,7 +65,7 @@ func (e *excluded_with_allValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field must be absent because all other fields are present.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent when all of [@FIELDS] are present", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent when all of [@FIELDS] are present", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sExcludedWithAllValidation", e.structName, e.FieldName())
@@ -77,7 +76,8 @@ func (e *excluded_with_allValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", e.FieldName(),
 		"[@PATH]", e.FieldPath().String(),
-		"[@FIELDS]", strings.Join(e.fields, ", "),
+		"[@FIELDS]", strings.Join(e.fields.Paths(), ", "),
+		"[@PARAM]", e.fields.Param(),
 		"[@TYPE]", e.ruleName,
 	)
 
@@ -93,18 +93,25 @@ func (e *excluded_with_allValidator) ErrVariable() string {
 }
 
 func (e *excluded_with_allValidator) Imports() []string {
-	return []string{}
+	_, imports := e.condition()
+
+	return imports
+}
+
+// DependsOn implements validator.DependentValidator.
+func (e *excluded_with_allValidator) DependsOn() []string {
+	return e.fields.Paths()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (e *excluded_with_allValidator) UsesRoot() bool {
+	return e.fields.UsesRoot()
 }
 
 // ValidateExcludedWithAll creates a new excluded_with_allValidator.
 func ValidateExcludedWithAll(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerExcluded_with_all]
-	if !ok {
-		return nil
-	}
-
-	fields := strings.Fields(expr)
-	if len(fields) == 0 {
+	fields := parseFieldRefs(input, markers.GoValidMarkerExcluded_with_all)
+	if fields == nil {
 		return nil
 	}
 
@@ -16,28 +16,27 @@ import (
 type excluded_withoutValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	fields     []string
+	fields     fieldRefs
 	structName string
 	ruleName   string
 	parentPath string
 }
 
-var _ validator.Validator = (*excluded_withoutValidator)(nil)
+var _ validator.DependentValidator = (*excluded_withoutValidator)(nil)
 
 const excluded_withoutKey = "%s-excluded_without"
 
 func (e *excluded_withoutValidator) Validate() string {
 	typ := e.pass.TypesInfo.TypeOf(e.field.Type)
 	zero := getZeroValueForType(typ)
-	fieldName := e.FieldName()
+	condition, _ := e.condition()
 
-	var conditions []string
-	for _, f := range e.fields {
-		conditions = append(conditions, fmt.Sprintf(`t.%s == ""`, f))
-	}
+	return fmt.Sprintf("%s && t.%s != %s", condition, e.FieldName(), zero)
+}
 
-	return fmt.Sprintf("(%s) && t.%s != %s",
-		strings.Join(conditions, " || "), fieldName, zero)
+// condition returns the condition on the other fields under which the rule applies, and its imports.
+func (e *excluded_withoutValidator) condition() (string, []string) {
+	return e.fields.Any(false)
 }
 
 func (e *excluded_withoutValidator) FieldName() string {
@@ -66,7 +65,7 @@ func (e *excluded_withoutValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field must be absent because other fields are absent.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent when any of [@FIELDS] are absent", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be absent when any of [@FIELDS] are absent", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sExcludedWithoutValidation", e.structName, e.FieldName())
@@ -77,7 +76,8 @@ func (e *excluded_withoutValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", e.FieldName(),
 		"[@PATH]", e.FieldPath().String(),
-		"[@FIELDS]", strings.Join(e.fields, ", "),
+		"[@FIELDS]", strings.Join(e.fields.Paths(), ", "),
+		"[@PARAM]", e.fields.Param(),
 		"[@TYPE]", e.ruleName,
 	)
 
@@ -93,18 +93,25 @@ func (e *excluded_withoutValidator) ErrVariable() string {
 }
 
 func (e *excluded_withoutValidator) Imports() []string {
-	return []string{}
+	_, imports := e.condition()
+
+	return imports
+}
+
+// DependsOn implements validator.DependentValidator.
+func (e *excluded_withoutValidator) DependsOn() []string {
+	return e.fields.Paths()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (e *excluded_withoutValidator) UsesRoot() bool {
+	return e.fields.UsesRoot()
 }
 
 // ValidateExcludedWithout creates a new excluded_withoutValidator.
 func ValidateExcludedWithout(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerExcluded_without]
-	if !ok {
-		return nil
-	}
-
-	fields := strings.Fields(expr)
-	if len(fields) == 0 {
+	fields := parseFieldRefs(input, markers.GoValidMarkerExcluded_without)
+	if fields == nil {
 		return nil
 	}
 
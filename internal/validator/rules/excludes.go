@@ -28,8 +28,7 @@ var _ validator.Validator = (*excludesValidator)(nil)
 const excludesKey = "%s-excludes"
 
 func (e *excludesValidator) Validate() string {
-	fieldName := e.FieldName()
-	return fmt.Sprintf("strings.Contains(t.%s, %q)", fieldName, e.substr)
+	return fmt.Sprintf("strings.Contains(%s, %q)", stringField(e.pass, e.field), e.substr)
 }
 
 func (e *excludesValidator) FieldName() string {
@@ -58,7 +57,7 @@ func (e *excludesValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field contains the excluded substring.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not contain: [@SUBSTR]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not contain: [@SUBSTR]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@SUBSTR]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sExcludesValidation", e.structName, e.FieldName())
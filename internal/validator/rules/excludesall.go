@@ -28,8 +28,7 @@ var _ validator.Validator = (*excludesallValidator)(nil)
 const excludesallKey = "%s-excludesall"
 
 func (e *excludesallValidator) Validate() string {
-	fieldName := e.FieldName()
-	return fmt.Sprintf("strings.ContainsAny(t.%s, %q)", fieldName, e.chars)
+	return fmt.Sprintf("strings.ContainsAny(%s, %q)", stringField(e.pass, e.field), e.chars)
 }
 
 func (e *excludesallValidator) FieldName() string {
@@ -58,7 +57,7 @@ func (e *excludesallValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field contains any of the excluded characters.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not contain any of these characters: [@CHARS]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not contain any of these characters: [@CHARS]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@CHARS]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sExcludesallValidation", e.structName, e.FieldName())
@@ -0,0 +1,393 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// exprValidator evaluates a boolean Go expression against a field, or against the whole struct
+// when field is nil, for struct-level rules declared on the type.
+type exprValidator struct {
+	field      *ast.Field
+	structName string
+	ruleName   string
+	parentPath string
+	// suffix distinguishes the error variables of the struct-level rules repeated on a type.
+	suffix string
+	// expression is the expression as written in the marker.
+	expression string
+	// condition is the Go expression holding for valid values, where self and value are rewritten
+	// to the struct and the field of the generated code.
+	condition string
+	imports   []string
+	dependsOn []string
+}
+
+var _ validator.DependentValidator = (*exprValidator)(nil)
+
+const exprKey = "%s-expr"
+
+func (e *exprValidator) Validate() string {
+	return fmt.Sprintf("!(%s)", e.condition)
+}
+
+func (e *exprValidator) FieldName() string {
+	if e.field == nil {
+		return ""
+	}
+
+	return e.field.Names[0].Name
+}
+
+func (e *exprValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(e.structName, e.parentPath, e.FieldName())
+}
+
+func (e *exprValidator) Err() string {
+	key := fmt.Sprintf(exprKey, e.FieldPath().CleanedPath()+e.suffix)
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the Go expression of the field does not hold.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] failed expression validation: [@EXPRESSION]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@EXPRESSION]"}
+	`
+
+	const structErrTemplate = `
+		// [@ERRVARIABLE] is the error returned when the struct-level Go expression does not hold.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "[@PATH] failed expression validation: [@EXPRESSION]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@EXPRESSION]"}
+	`
+
+	// The expression is embedded in string literals, where its quotes and backslashes are escaped
+	quoted := strconv.Quote(e.expression)
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", e.ErrVariable(),
+		"[@FIELD]", e.FieldName(),
+		"[@PATH]", e.FieldPath().String(),
+		"[@EXPRESSION]", quoted[1:len(quoted)-1],
+		"[@TYPE]", e.ruleName,
+	)
+
+	if e.field == nil {
+		return replacer.Replace(structErrTemplate)
+	}
+
+	return replacer.Replace(errTemplate)
+}
+
+func (e *exprValidator) ErrVariable() string {
+	return "Err" + e.FieldPath().CleanedPath() + "Expr" + e.suffix + "Validation"
+}
+
+// DependsOn implements validator.DependentValidator, returning the fields referenced through self,
+// and for struct-level rules through value.
+func (e *exprValidator) DependsOn() []string {
+	return e.dependsOn
+}
+
+func (e *exprValidator) Imports() []string {
+	return e.imports
+}
+
+// ValidateExpr creates a new exprValidator for fields with the expr marker, a boolean expression written
+// in Go, e.g., +govalid:expr=len(self.Tags) <= self.MaxTags && strings.HasPrefix(value, "x-"), where
+// self is the struct holding the field and value is the field. Without a field, the expression is
+// a struct-level rule where self and value are the struct.
+// The expression is type-checked against the package of the struct, with the imports of its file;
+// other packages are found among the dependencies of the package, or by path, such as strings.
+// An expression that does not parse, does not type-check or is not a boolean is reported as a
+// diagnostic, returning nil.
+func ValidateExpr(input registry.ValidatorInput) validator.Validator {
+	expression, ok := input.Expressions[markers.GoValidMarkerExpr]
+	if !ok || strings.TrimSpace(expression) == "" {
+		return nil
+	}
+
+	e := &exprValidator{
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+		expression: strings.TrimSpace(expression),
+		suffix:     occurrenceSuffix(input.Occurrence),
+	}
+
+	if !e.check(input) {
+		return nil
+	}
+
+	return e
+}
+
+// check parses and type-checks the expression, setting the condition, imports and dependencies of
+// the rule. Errors are reported at the position of the field, or of the type for struct-level rules.
+func (e *exprValidator) check(input registry.ValidatorInput) bool {
+	pass := input.Pass
+
+	pos, self, value := e.operands(pass)
+	if self == nil {
+		return false
+	}
+
+	fset := token.NewFileSet()
+
+	expr, err := parser.ParseExprFrom(fset, "", e.expression, parser.SkipObjectResolution)
+	if err != nil {
+		input.Report(pos, "%s: %v", input.RuleName, err)
+
+		return false
+	}
+
+	// The expression is checked in a package of the same path, so the unexported fields of the struct
+	// are accessible, holding self and value, which shadow the objects of the package named alike
+	pkg := types.NewPackage(pass.Pkg.Path(), pass.Pkg.Name())
+	selfVar := types.NewVar(token.NoPos, pkg, "self", self)
+	valueVar := types.NewVar(token.NoPos, pkg, "value", value)
+
+	scope := pkg.Scope()
+	scope.Insert(selfVar)
+	scope.Insert(valueVar)
+
+	for _, name := range pass.Pkg.Scope().Names() {
+		scope.Insert(pass.Pkg.Scope().Lookup(name))
+	}
+
+	// The imports of the file are declared anew in the package, as go/types requires the package names
+	// of an expression to belong to the package it checks
+	if file := pass.Pkg.Scope().Innermost(pos); file != nil {
+		for _, name := range file.Names() {
+			obj := file.Lookup(name)
+			if pkgName, ok := obj.(*types.PkgName); ok {
+				obj = types.NewPkgName(token.NoPos, pkg, name, pkgName.Imported())
+			}
+
+			scope.Insert(obj)
+		}
+	}
+
+	importQualifiers(pass, scope, expr)
+
+	info := &types.Info{
+		Types: map[ast.Expr]types.TypeAndValue{},
+		Uses:  map[*ast.Ident]types.Object{},
+	}
+
+	if err := types.CheckExpr(fset, pkg, token.NoPos, expr, info); err != nil {
+		var typeErr types.Error
+		if errors.As(err, &typeErr) {
+			err = errors.New(typeErr.Msg)
+		}
+
+		input.Report(pos, "%s: %v", input.RuleName, err)
+
+		return false
+	}
+
+	if basic, ok := info.Types[expr].Type.Underlying().(*types.Basic); !ok || basic.Info()&types.IsBoolean == 0 {
+		input.Report(pos, "%s: %s is of type %s, not a boolean", input.RuleName, e.expression, info.Types[expr].Type)
+
+		return false
+	}
+
+	e.rewrite(expr, info, selfVar, valueVar)
+
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, expr); err != nil {
+		input.Report(pos, "%s: %v", input.RuleName, err)
+
+		return false
+	}
+
+	e.condition = buf.String()
+
+	return true
+}
+
+// operands returns the position of the rule and the types of self and value: the struct holding
+// the field and the field, or the struct for struct-level rules. Self is nil when they are unknown.
+func (e *exprValidator) operands(pass *codegen.Pass) (token.Pos, types.Type, types.Type) {
+	if e.field == nil {
+		obj := pass.Pkg.Scope().Lookup(e.structName)
+		if obj == nil {
+			return token.NoPos, nil, nil
+		}
+
+		return obj.Pos(), obj.Type(), obj.Type()
+	}
+
+	value := pass.TypesInfo.TypeOf(e.field.Type)
+	if value == nil {
+		return token.NoPos, nil, nil
+	}
+
+	if self := enclosingStruct(pass, e.field); self != nil {
+		return e.field.Pos(), self, value
+	}
+
+	// The value of a named non-struct type is validated as the only field of a struct
+	self := types.NewStruct([]*types.Var{types.NewField(e.field.Pos(), pass.Pkg, e.FieldName(), value, false)}, nil)
+
+	return e.field.Pos(), self, value
+}
+
+// enclosingStruct returns the type of the struct declaring field, named after the type declaring the
+// struct if any, or nil.
+func enclosingStruct(pass *codegen.Pass, field *ast.Field) types.Type {
+	var typ types.Type
+
+	for _, file := range pass.Files {
+		if field.Pos() < file.Pos() || field.Pos() > file.End() {
+			continue
+		}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.TypeSpec:
+				if st, ok := n.Type.(*ast.StructType); ok && slices.Contains(st.Fields.List, field) {
+					typ = pass.TypesInfo.TypeOf(n.Name)
+				}
+			case *ast.StructType:
+				if slices.Contains(n.Fields.List, field) {
+					typ = pass.TypesInfo.TypeOf(n)
+				}
+			}
+
+			return typ == nil
+		})
+	}
+
+	return typ
+}
+
+// importQualifiers declares in scope the packages qualifying the identifiers of expr that the file
+// does not import, found by name among the dependencies of the package, or else imported by path.
+func importQualifiers(pass *codegen.Pass, scope *types.Scope, expr ast.Expr) {
+	var imp types.Importer
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		ident, ok := sel.X.(*ast.Ident)
+		if !ok || scope.Lookup(ident.Name) != nil || types.Universe.Lookup(ident.Name) != nil {
+			return true
+		}
+
+		imported := dependency(pass.Pkg, ident.Name)
+		if imported == nil {
+			if imp == nil {
+				imp = importer.Default()
+			}
+
+			if pkg, err := imp.Import(ident.Name); err == nil && pkg.Name() == ident.Name {
+				imported = pkg
+			}
+		}
+
+		if imported != nil {
+			scope.Insert(types.NewPkgName(token.NoPos, scope.Lookup("self").Pkg(), ident.Name, imported))
+		}
+
+		return true
+	})
+}
+
+// dependency returns the package named name among the transitive imports of pkg, preferring the
+// package whose path is its name, or nil.
+func dependency(pkg *types.Package, name string) *types.Package {
+	var found *types.Package
+
+	seen := map[*types.Package]bool{}
+	queue := slices.Clone(pkg.Imports())
+
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+
+		if seen[p] {
+			continue
+		}
+
+		seen[p] = true
+
+		if p.Name() == name {
+			if p.Path() == name {
+				return p
+			}
+
+			if found == nil {
+				found = p
+			}
+		}
+
+		queue = append(queue, p.Imports()...)
+	}
+
+	return found
+}
+
+// rewrite rewrites self and value in expr to the struct and the field of the generated code, and the
+// qualifiers of packages to the names they are imported under, collecting the imports and the fields
+// referenced through self, or through value for struct-level rules.
+func (e *exprValidator) rewrite(expr ast.Expr, info *types.Info, selfVar, valueVar *types.Var) {
+	valueExpr := "t"
+	if e.field != nil {
+		valueExpr = "t." + e.FieldName()
+	}
+
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				obj := info.Uses[ident]
+				if (obj == selfVar || (e.field == nil && obj == valueVar)) &&
+					sel.Sel.Name != e.FieldName() && !slices.Contains(e.dependsOn, sel.Sel.Name) {
+					e.dependsOn = append(e.dependsOn, sel.Sel.Name)
+				}
+			}
+		}
+
+		ident, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		switch obj := info.Uses[ident].(type) {
+		case *types.Var:
+			switch obj {
+			case selfVar:
+				ident.Name = "t"
+			case valueVar:
+				ident.Name = valueExpr
+			}
+		case *types.PkgName:
+			ident.Name = obj.Imported().Name()
+			e.imports = appendImports(e.imports, obj.Imported().Path())
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,373 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// comparison describes how a cross-field rule compares the field with the other field.
+type comparison struct {
+	// op is the Go operator of the failing condition, e.g., != for eqfield.
+	op string
+	// timeCheck is the time.Time method call of the failing condition, with %s for the field
+	// and the other field, e.g., "!%s.Equal(%s)" for eqfield.
+	timeCheck string
+	// ordered reports whether the rule orders the values rather than testing their equality.
+	ordered bool
+	// reason describes the constraint in the error, e.g., "must be equal to".
+	reason string
+}
+
+var (
+	compareEq  = comparison{op: "!=", timeCheck: "!%s.Equal(%s)", reason: "must be equal to"}
+	compareNe  = comparison{op: "==", timeCheck: "%s.Equal(%s)", reason: "must not be equal to"}
+	compareGt  = comparison{op: "<=", timeCheck: "!%s.After(%s)", ordered: true, reason: "must be greater than"}
+	compareGte = comparison{op: "<", timeCheck: "%s.Before(%s)", ordered: true, reason: "must be greater than or equal to"}
+	compareLt  = comparison{op: ">=", timeCheck: "!%s.Before(%s)", ordered: true, reason: "must be less than"}
+	compareLte = comparison{op: ">", timeCheck: "%s.After(%s)", ordered: true, reason: "must be less than or equal to"}
+)
+
+// fieldComparison is the common part of the cross-field comparison rules, comparing the field with
+// another field of the same struct, e.g., eqfield=Password, or for the *csfield variants with the
+// field at a dot-separated path from the validated struct, e.g., gtcsfield=Window.Start.
+type fieldComparison struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	structName string
+	ruleName   string
+	parentPath string
+	comparison comparison
+	// other is the path of the other field as written in the marker.
+	other string
+	// cross reports whether other is a path from the validated struct rather than a sibling field.
+	cross bool
+	// otherExpr is the Go expression of the other field and guards the conditions checking that
+	// the pointers on its path are not nil.
+	otherExpr string
+	guards    []string
+	isTime    bool
+	// fieldPointer and otherPointer report whether the fields are pointers, compared by value when
+	// neither is nil.
+	fieldPointer bool
+	otherPointer bool
+}
+
+// newFieldComparison type-checks the field and the other field of the marker, reporting a diagnostic
+// and returning nil when the other field does not exist or the fields cannot be compared.
+func newFieldComparison(input registry.ValidatorInput, marker string, c comparison, cross bool) *fieldComparison {
+	other := strings.TrimSpace(input.Expressions[marker])
+	if other == "" || input.Field == nil {
+		return nil
+	}
+
+	fc := &fieldComparison{
+		pass:       input.Pass,
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+		comparison: c,
+		other:      other,
+		cross:      cross,
+	}
+
+	root := lookupStruct(input.Pass, input.StructName)
+
+	// The other field of the non-cs variants is a sibling, in the struct holding the field
+	base, prefix := root, "root"
+	if !cross {
+		base, prefix = structAt(root, strings.Split(input.ParentPath, ".")), "t"
+	} else if input.ParentPath == "" {
+		prefix = "t"
+	}
+
+	path := strings.Split(other, ".")
+
+	// A struct validated on its own rather than nested in the struct the path starts from,
+	// such as the element type of a dived slice, leaves the rule to the enclosing struct.
+	if cross && input.ParentPath == "" && structFieldType(base, path[0]) == nil && nestedStruct(input.Pass, input.StructName) {
+		return nil
+	}
+
+	otherType, guards, ok := resolveFieldPath(base, prefix, path)
+	if !ok {
+		input.Report(input.Field.Pos(), "%s: field %s not found in %s", input.RuleName, other, input.StructName)
+
+		return nil
+	}
+
+	fc.otherExpr = prefix + "." + other
+	fc.guards = guards
+
+	fieldType := input.Pass.TypesInfo.TypeOf(input.Field.Type)
+	name := input.Field.Names[0].Name
+
+	fieldType, fc.fieldPointer = derefPointer(fieldType)
+	otherType, fc.otherPointer = derefPointer(otherType)
+
+	if !types.Identical(fieldType, otherType) {
+		input.Report(input.Field.Pos(), "%s: %s of type %s cannot be compared with %s of type %s",
+			input.RuleName, name, fieldType, other, otherType)
+
+		return nil
+	}
+
+	fc.isTime = isTimeType(fieldType)
+
+	switch {
+	case fc.isTime:
+	case c.ordered && !isOrdered(fieldType):
+		input.Report(input.Field.Pos(), "%s: %s of type %s is not ordered", input.RuleName, name, fieldType)
+
+		return nil
+	case !types.Comparable(fieldType):
+		input.Report(input.Field.Pos(), "%s: %s of type %s is not comparable", input.RuleName, name, fieldType)
+
+		return nil
+	}
+
+	return fc
+}
+
+// derefPointer returns the element type of the pointer type typ, reporting whether typ is a pointer,
+// or typ.
+func derefPointer(typ types.Type) (types.Type, bool) {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		return ptr.Elem(), true
+	}
+
+	return typ, false
+}
+
+// nestedStruct reports whether the struct type named name is nested in another struct of the package,
+// as a field, a pointer or the elements of a collection, whose rules the struct validated on its own
+// leaves to the enclosing struct.
+func nestedStruct(pass *codegen.Pass, name string) bool {
+	obj := pass.Pkg.Scope().Lookup(name)
+	if obj == nil {
+		return false
+	}
+
+	for _, other := range pass.Pkg.Scope().Names() {
+		st := lookupStruct(pass, other)
+		if other == name || st == nil {
+			continue
+		}
+
+		for i := range st.NumFields() {
+			if types.Identical(elemOf(st.Field(i).Type()), obj.Type()) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// elemOf returns the type of the values held by typ through pointers and collections, or typ.
+func elemOf(typ types.Type) types.Type {
+	for {
+		switch u := typ.Underlying().(type) {
+		case *types.Pointer:
+			typ = u.Elem()
+		case *types.Slice:
+			typ = u.Elem()
+		case *types.Array:
+			typ = u.Elem()
+		case *types.Map:
+			typ = u.Elem()
+		default:
+			return typ
+		}
+	}
+}
+
+// structAt returns the struct type at the path of struct fields from st, where the elements
+// of collections are denoted with [i], or nil.
+func structAt(st *types.Struct, path []string) *types.Struct {
+	for _, segment := range path {
+		if segment == "" {
+			continue
+		}
+
+		typ := structFieldType(st, strings.TrimSuffix(segment, "[i]"))
+		if typ == nil {
+			return nil
+		}
+
+		for {
+			switch u := typ.Underlying().(type) {
+			case *types.Pointer:
+				typ = u.Elem()
+
+				continue
+			case *types.Slice:
+				typ = u.Elem()
+
+				continue
+			case *types.Array:
+				typ = u.Elem()
+
+				continue
+			case *types.Struct:
+				st = u
+			default:
+				return nil
+			}
+
+			break
+		}
+	}
+
+	return st
+}
+
+// resolveFieldPath returns the type of the field at the dot-separated path from st, and the
+// conditions checking that the pointers to the structs on the path, from the variable v, are not nil.
+func resolveFieldPath(st *types.Struct, v string, path []string) (types.Type, []string, bool) {
+	var guards []string
+
+	expr := v
+
+	for i, segment := range path {
+		typ := structFieldType(st, segment)
+		if typ == nil {
+			return nil, nil, false
+		}
+
+		expr += "." + segment
+
+		if i == len(path)-1 {
+			return typ, guards, true
+		}
+
+		if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+			guards = append(guards, expr+" != nil")
+			typ = ptr.Elem()
+		}
+
+		next, ok := typ.Underlying().(*types.Struct)
+		if !ok {
+			return nil, nil, false
+		}
+
+		st = next
+	}
+
+	return nil, nil, false
+}
+
+// isTimeType reports whether typ is time.Time.
+func isTimeType(typ types.Type) bool {
+	named, ok := types.Unalias(typ).(*types.Named)
+
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == "time" && named.Obj().Name() == "Time"
+}
+
+// isOrdered reports whether the values of typ can be compared with <, such as numbers,
+// strings and time.Duration.
+func isOrdered(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+
+	return ok && basic.Info()&types.IsOrdered != 0
+}
+
+func (f *fieldComparison) Validate() string {
+	field := fmt.Sprintf("t.%s", f.FieldName())
+	other := f.otherExpr
+	guards := slices.Clone(f.guards)
+
+	// Pointers are compared by value, when neither is nil
+	if f.fieldPointer {
+		guards = append(guards, field+" != nil")
+		field = "*" + field
+	}
+
+	if f.otherPointer {
+		guards = append(guards, other+" != nil")
+		other = "*" + other
+	}
+
+	check := fmt.Sprintf("%s %s %s", field, f.comparison.op, other)
+	if f.isTime {
+		check = fmt.Sprintf(f.comparison.timeCheck, groupDeref(field), other)
+	}
+
+	if len(guards) == 0 {
+		return check
+	}
+
+	return fmt.Sprintf("%s && %s", strings.Join(guards, " && "), check)
+}
+
+// groupDeref parenthesizes the dereferenced pointer expr, as the receiver of a method call.
+func groupDeref(expr string) string {
+	if strings.HasPrefix(expr, "*") {
+		return "(" + expr + ")"
+	}
+
+	return expr
+}
+
+func (f *fieldComparison) FieldName() string {
+	return f.field.Names[0].Name
+}
+
+func (f *fieldComparison) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(f.structName, f.parentPath, f.FieldName())
+}
+
+// DependsOn implements validator.DependentValidator, returning the sibling field compared with.
+func (f *fieldComparison) DependsOn() []string {
+	if f.cross {
+		return nil
+	}
+
+	return []string{f.other}
+}
+
+// UsesRoot implements validator.RootValidator, reporting whether the other field is reached
+// from the validated struct within a nested struct.
+func (f *fieldComparison) UsesRoot() bool {
+	return strings.HasPrefix(f.otherExpr, "root.")
+}
+
+func (f *fieldComparison) Imports() []string {
+	return []string{}
+}
+
+// err returns the declaration of the error variable of the rule.
+func (f *fieldComparison) err(key, errVariable string) string {
+	key = fmt.Sprintf(key, f.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the field [@FIELD] [@REASON] [@OTHER].
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] [@REASON] [@OTHER]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@OTHER]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", errVariable,
+		"[@FIELD]", f.FieldName(),
+		"[@REASON]", f.comparison.reason,
+		"[@OTHER]", f.other,
+		"[@PATH]", f.FieldPath().String(),
+		"[@TYPE]", f.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
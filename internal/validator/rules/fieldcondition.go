@@ -0,0 +1,258 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/constant"
+	"go/types"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// fieldCondition is a Field Value pair of the conditional rules, e.g., Status active in required_if=Status active.
+type fieldCondition struct {
+	ref fieldRef
+	// value is the Go expression of the value, typed from the field.
+	value string
+	// imports are the packages of the constant the value names, if any.
+	imports []string
+	// pointer reports whether the field is a pointer, the condition then requiring it not to be nil.
+	pointer bool
+	// op is the comparison operator, == when empty.
+	op string
+}
+
+func (c fieldCondition) String() string {
+	conds := slices.Clone(c.ref.guards)
+	value := c.ref.expr
+
+	if c.pointer {
+		conds = append(conds, c.ref.expr+" != nil")
+		value = "*" + value
+	}
+
+	op := c.op
+	if op == "" {
+		op = "=="
+	}
+
+	return strings.Join(append(conds, fmt.Sprintf("%s %s %s", value, op, c.value)), " && ")
+}
+
+// fieldConditions are the pairs of a conditional rule, which is met when all of them hold.
+type fieldConditions []fieldCondition
+
+// Met returns the Go condition reporting whether all the pairs hold.
+func (c fieldConditions) Met() string {
+	conds := make([]string, len(c))
+	for i, cond := range c {
+		conds[i] = cond.String()
+	}
+
+	return strings.Join(conds, " && ")
+}
+
+// NotMet returns the Go condition reporting whether any of the pairs does not hold.
+func (c fieldConditions) NotMet() string {
+	if len(c) == 1 && !c[0].pointer && len(c[0].ref.guards) == 0 {
+		return fmt.Sprintf("%s != %s", c[0].ref.expr, c[0].value)
+	}
+
+	return fmt.Sprintf("!(%s)", c.Met())
+}
+
+// Fields returns the paths of the fields the conditions depend on.
+func (c fieldConditions) Fields() []string {
+	fields := make([]string, len(c))
+	for i, cond := range c {
+		fields[i] = cond.ref.path
+	}
+
+	return fields
+}
+
+// Imports returns the packages of the constants the values name.
+func (c fieldConditions) Imports() []string {
+	var imports []string
+	for _, cond := range c {
+		imports = appendImports(imports, cond.imports...)
+	}
+
+	return imports
+}
+
+// UsesRoot reports whether any of the fields is reached from root.
+func (c fieldConditions) UsesRoot() bool {
+	for _, cond := range c {
+		if cond.ref.usesRoot() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Describe describes the pairs in an error reason, e.g., Status equals \"active\" and Count equals 3.
+func (c fieldConditions) Describe() string {
+	described := make([]string, len(c))
+	for i, cond := range c {
+		described[i] = fmt.Sprintf("%s equals %s", cond.ref.path, escapeQuotes(cond.value))
+	}
+
+	return strings.Join(described, " and ")
+}
+
+// Param returns the pairs as the parameter of an error, e.g., Status \"active\" Count 3.
+func (c fieldConditions) Param() string {
+	params := make([]string, 0, 2*len(c))
+	for _, cond := range c {
+		params = append(params, cond.ref.path, escapeQuotes(cond.value))
+	}
+
+	return strings.Join(params, " ")
+}
+
+func escapeQuotes(s string) string {
+	return strings.ReplaceAll(s, `"`, `\"`)
+}
+
+// parseFieldConditions parses the Field Value pairs of a conditional marker, e.g.,
+// required_if=Status active Count 3, typing each value from the type of its field.
+// Fields are referenced as resolveFieldRef resolves them. Values of string fields are quoted unless
+// they are already, or are constants of the named type of the field. A malformed marker, a field that
+// does not exist and a value that is not valid for its field are reported as diagnostics, returning nil.
+func parseFieldConditions(input registry.ValidatorInput, marker string) fieldConditions {
+	expr, ok := input.Expressions[marker]
+	if !ok || input.Field == nil {
+		return nil
+	}
+
+	tokens := splitConditionTokens(expr)
+	if len(tokens) == 0 || len(tokens)%2 != 0 {
+		input.Report(input.Field.Pos(), "%s: expected Field Value pairs, got %q", input.RuleName, expr)
+
+		return nil
+	}
+
+	conditions := make(fieldConditions, 0, len(tokens)/2)
+
+	for i := 0; i < len(tokens); i += 2 {
+		raw := tokens[i+1]
+
+		if outsideStruct(input, tokens[i]) {
+			return nil
+		}
+
+		ref, ok := resolveFieldRef(input, tokens[i])
+		if !ok {
+			return nil
+		}
+
+		cond := fieldCondition{ref: ref}
+		typ := ref.typ
+
+		if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+			typ = ptr.Elem()
+			cond.pointer = true
+		}
+
+		value, imports, ok := conditionValue(input, typ, raw)
+		if !ok {
+			input.Report(input.Field.Pos(), "%s: %s is not a valid value of %s of type %s", input.RuleName, raw, ref.path, typ)
+
+			return nil
+		}
+
+		cond.value, cond.imports = value, imports
+		conditions = append(conditions, cond)
+	}
+
+	return conditions
+}
+
+// splitConditionTokens splits the expression of a conditional marker on spaces, keeping quoted values together.
+func splitConditionTokens(expr string) []string {
+	var tokens []string
+
+	for expr = strings.TrimSpace(expr); expr != ""; expr = strings.TrimSpace(expr) {
+		if quote := expr[0]; quote == '"' || quote == '`' {
+			if end := strings.IndexByte(expr[1:], quote); end >= 0 {
+				tokens = append(tokens, expr[:end+2])
+				expr = expr[end+2:]
+
+				continue
+			}
+		}
+
+		end := strings.IndexAny(expr, " \t")
+		if end < 0 {
+			end = len(expr)
+		}
+
+		tokens = append(tokens, expr[:end])
+		expr = expr[end:]
+	}
+
+	return tokens
+}
+
+// conditionValue returns the Go expression of the value raw compared with a field of type typ and
+// the imports it needs, reporting false when it is not a valid value of typ. Constants resolve in
+// the scope of the file declaring the field, see lookupConst, where the other values are evaluated.
+func conditionValue(input registry.ValidatorInput, typ types.Type, raw string) (string, []string, bool) {
+	quoted := strings.HasPrefix(raw, `"`) || strings.HasPrefix(raw, "`")
+
+	var (
+		expr      = raw
+		imports   []string
+		valueType types.Type
+		valid     bool
+	)
+
+	if arg, c, ok := lookupConst(input, raw); ok {
+		expr, imports, valueType = arg.expr, arg.imports, c.Type()
+		valid = constAssignable(c, typ)
+	} else {
+		tv, err := types.Eval(input.Pass.Fset, input.Pass.Pkg, input.Field.Pos(), raw)
+		valueType = tv.Type
+		valid = err == nil && tv.Value != nil && types.AssignableTo(tv.Type, typ) && representable(tv.Value, typ)
+	}
+
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsString == 0 || quoted {
+		return expr, imports, valid
+	}
+
+	// A bare word compared with a string is a string, unless it is a constant of the named type of the field
+	if _, named := types.Unalias(typ).(*types.Named); named && valid && types.Identical(valueType, typ) {
+		return expr, imports, true
+	}
+
+	return strconv.Quote(raw), nil, true
+}
+
+// representable reports whether the constant value can be represented by the basic type underlying typ.
+func representable(value constant.Value, typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	if !ok {
+		return true
+	}
+
+	info := basic.Info()
+
+	switch {
+	case info&types.IsInteger != 0:
+		return constant.ToInt(value).Kind() == constant.Int
+	case info&types.IsFloat != 0:
+		return constant.ToFloat(value).Kind() == constant.Float
+	case info&types.IsBoolean != 0:
+		return value.Kind() == constant.Bool
+	case info&types.IsString != 0:
+		return value.Kind() == constant.String
+	default:
+		return true
+	}
+}
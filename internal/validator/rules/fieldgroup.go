@@ -0,0 +1,205 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+// fieldGroup is the common part of the field-group constraints, struct-level rules
+// declared on a type over a list of its fields, e.g., +govalid:exactly_one_of=Card Wallet.
+type fieldGroup struct {
+	pass       *codegen.Pass
+	structName string
+	ruleName   string
+	fields     []string
+	// suffix distinguishes the error variables of the groups of the same rule, see occurrenceSuffix.
+	suffix string
+	// checks are the conditions reporting whether each field is set.
+	checks  []string
+	imports []string
+}
+
+// newFieldGroup returns the field group of the marker expression, or nil if the marker is not declared
+// on a struct type. Unknown fields and lists of fewer than two fields are reported, returning nil.
+func newFieldGroup(input registry.ValidatorInput, marker string) *fieldGroup {
+	if input.Field != nil {
+		return nil
+	}
+
+	var pos token.Pos
+	if obj := input.Pass.Pkg.Scope().Lookup(input.StructName); obj != nil {
+		pos = obj.Pos()
+	}
+
+	fields := strings.Fields(input.Expressions[marker])
+	if len(fields) < 2 {
+		input.Report(pos, "%s: at least two fields are required, got %d", input.RuleName, len(fields))
+
+		return nil
+	}
+
+	g := &fieldGroup{
+		pass:       input.Pass,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		fields:     fields,
+		suffix:     occurrenceSuffix(input.Occurrence),
+	}
+
+	st := lookupStruct(input.Pass, input.StructName)
+	for _, field := range fields {
+		typ := structFieldType(st, field)
+		if typ == nil {
+			input.Report(pos, "%s: unknown field %s in %s", input.RuleName, field, input.StructName)
+
+			return nil
+		}
+
+		check, imports := isSet("t."+field, typ)
+		g.checks = append(g.checks, check)
+		g.imports = append(g.imports, imports...)
+	}
+
+	return g
+}
+
+// occurrenceSuffix returns the suffix of the names of the error variables of a struct-level rule
+// repeated on a type: none for the first marker of the rule, then its position, e.g., 2 in
+// ErrPaymentExactlyOneOf2Validation.
+func occurrenceSuffix(occurrence int) string {
+	if occurrence == 0 {
+		return ""
+	}
+
+	return strconv.Itoa(occurrence + 1)
+}
+
+// lookupStruct returns the struct type of the named type of the package, or nil.
+func lookupStruct(pass *codegen.Pass, name string) *types.Struct {
+	obj := pass.Pkg.Scope().Lookup(name)
+	if obj == nil {
+		return nil
+	}
+
+	st, _ := obj.Type().Underlying().(*types.Struct)
+
+	return st
+}
+
+// structFieldType returns the type of the named field of st, or nil.
+func structFieldType(st *types.Struct, name string) types.Type {
+	if st == nil {
+		return nil
+	}
+
+	for i := range st.NumFields() {
+		if st.Field(i).Name() == name {
+			return st.Field(i).Type()
+		}
+	}
+
+	return nil
+}
+
+// isSet returns the condition reporting whether the expression holds a value other than its zero value,
+// collections being set when they are not empty, and the imports of the condition.
+func isSet(expr string, typ types.Type) (string, []string) {
+	if typ != nil {
+		switch typ.Underlying().(type) {
+		case *types.Slice, *types.Map:
+			return fmt.Sprintf("len(%s) > 0", expr), nil
+		case *types.Chan:
+			return fmt.Sprintf("%s != nil", expr), nil
+		}
+
+		if zero := validatorhelper.Zero(typ); zero != "" {
+			return fmt.Sprintf("%s != %s", expr, zero), nil
+		}
+	}
+
+	return fmt.Sprintf("!reflect.ValueOf(%s).IsZero()", expr), []string{"reflect"}
+}
+
+// isUnset returns the negation of isSet.
+func isUnset(expr string, typ types.Type) (string, []string) {
+	if typ != nil {
+		switch typ.Underlying().(type) {
+		case *types.Slice, *types.Map:
+			return fmt.Sprintf("len(%s) == 0", expr), nil
+		case *types.Chan:
+			return fmt.Sprintf("%s == nil", expr), nil
+		}
+
+		if zero := validatorhelper.Zero(typ); zero != "" {
+			return fmt.Sprintf("%s == %s", expr, zero), nil
+		}
+	}
+
+	return fmt.Sprintf("reflect.ValueOf(%s).IsZero()", expr), []string{"reflect"}
+}
+
+// count returns the expression counting the fields that are set.
+func (g *fieldGroup) count() string {
+	return fmt.Sprintf("validationhelper.CountTrue(%s)", strings.Join(g.checks, ", "))
+}
+
+func (g *fieldGroup) FieldName() string {
+	return ""
+}
+
+func (g *fieldGroup) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(g.structName)
+}
+
+// DependsOn implements validator.DependentValidator, returning the fields of the group.
+func (g *fieldGroup) DependsOn() []string {
+	return g.fields
+}
+
+func (g *fieldGroup) Imports() []string {
+	return g.imports
+}
+
+// errVariable returns the name of the error variable of the group, e.g., ErrPaymentExactlyOneOfValidation
+// for the rule name ExactlyOneOf.
+func (g *fieldGroup) errVariable(name string) string {
+	return "Err" + g.FieldPath().CleanedPath() + name + g.suffix + "Validation"
+}
+
+// err returns the declaration of the error variable of the group, once per group, with the reason
+// in which [@FIELDS] is replaced by the list of fields.
+func (g *fieldGroup) err(key, errVariable, reason string) string {
+	key = fmt.Sprintf(key, g.FieldPath().CleanedPath()+g.suffix)
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the fields [@FIELDS] of [@PATH] break the [@TYPE] constraint.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "[@REASON]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", errVariable,
+		"[@REASON]", strings.ReplaceAll(reason, "[@FIELDS]", strings.Join(g.fields, ", ")),
+		"[@FIELDS]", strings.Join(g.fields, ", "),
+		"[@PATH]", g.FieldPath().String(),
+		"[@TYPE]", g.ruleName,
+		"[@PARAM]", strings.Join(g.fields, " "),
+	)
+
+	return replacer.Replace(errTemplate)
+}
@@ -0,0 +1,219 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/types"
+	"slices"
+	"strings"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// fieldRef is a field referenced by a conditional rule: a field of the struct holding the validated
+// field, possibly through nested structs as in Address.Country, a field of an enclosing struct with
+// one more dot per level up as in ..Country, or a field at a path from the validated struct as in
+// $root.Country.
+type fieldRef struct {
+	// path is the reference as written in the marker.
+	path string
+	// expr is the Go expression of the field and guards the conditions checking that the pointers
+	// to the structs on its path are not nil.
+	expr   string
+	guards []string
+	typ    types.Type
+}
+
+// resolveFieldRef resolves the field referenced by path from the field of input, reporting a
+// diagnostic and false when it does not exist.
+func resolveFieldRef(input registry.ValidatorInput, path string) (fieldRef, bool) {
+	var parent []string
+	if input.ParentPath != "" {
+		parent = strings.Split(input.ParentPath, ".")
+	}
+
+	base, rel := parent, path
+
+	switch {
+	case strings.HasPrefix(path, validator.RootRefPrefix):
+		base, rel = nil, strings.TrimPrefix(path, validator.RootRefPrefix)
+	case strings.HasPrefix(path, ".."):
+		rel = strings.TrimLeft(path, ".")
+
+		levels := len(path) - len(rel) - 1
+		if levels > len(parent) {
+			input.Report(input.Field.Pos(), "%s: %s goes above %s", input.RuleName, path, input.StructName)
+
+			return fieldRef{}, false
+		}
+
+		base = parent[:len(parent)-levels]
+	}
+
+	// Fields outside of the struct holding the field are reached from root, the validated struct,
+	// the enclosing structs being those of the parent path within the same dive loop
+	prefix := "t"
+	if len(base) < len(parent) {
+		prefix = strings.Join(append([]string{"root"}, base...), ".")
+	}
+
+	typ, guards, ok := resolveFieldPath(structAt(lookupStruct(input.Pass, input.StructName), base), prefix, strings.Split(rel, "."))
+	if !ok {
+		input.Report(input.Field.Pos(), "%s: field %s not found in %s", input.RuleName, path, input.StructName)
+
+		return fieldRef{}, false
+	}
+
+	return fieldRef{path: path, expr: prefix + "." + rel, guards: guards, typ: typ}, true
+}
+
+// outsideStruct reports whether path references a field of an enclosing struct that a struct validated
+// on its own does not have, such as the element type of a dived slice, leaving the rule to the
+// enclosing struct.
+func outsideStruct(input registry.ValidatorInput, path string) bool {
+	if input.ParentPath != "" {
+		return false
+	}
+
+	if strings.HasPrefix(path, "..") {
+		return true
+	}
+
+	rel, ok := strings.CutPrefix(path, validator.RootRefPrefix)
+
+	return ok && structFieldType(lookupStruct(input.Pass, input.StructName), strings.Split(rel, ".")[0]) == nil
+}
+
+// parseFieldRefs resolves the space-separated fields of a conditional marker, e.g.,
+// required_with=Email ..Phone, returning nil when any of them cannot be resolved.
+func parseFieldRefs(input registry.ValidatorInput, marker string) fieldRefs {
+	expr, ok := input.Expressions[marker]
+	if !ok || input.Field == nil {
+		return nil
+	}
+
+	paths := strings.Fields(expr)
+	if len(paths) == 0 {
+		return nil
+	}
+
+	refs := make(fieldRefs, 0, len(paths))
+
+	for _, path := range paths {
+		if outsideStruct(input, path) {
+			return nil
+		}
+
+		ref, ok := resolveFieldRef(input, path)
+		if !ok {
+			return nil
+		}
+
+		refs = append(refs, ref)
+	}
+
+	return refs
+}
+
+// set returns the condition reporting whether the field holds a value other than its zero value,
+// a field below a nil pointer being unset, and the imports of the condition.
+func (r fieldRef) set() (string, []string) {
+	check, imports := isSet(r.expr, r.typ)
+	if len(r.guards) == 0 {
+		return check, imports
+	}
+
+	return fmt.Sprintf("(%s && %s)", strings.Join(r.guards, " && "), check), imports
+}
+
+// unset returns the negation of set.
+func (r fieldRef) unset() (string, []string) {
+	check, imports := isUnset(r.expr, r.typ)
+	if len(r.guards) == 0 {
+		return check, imports
+	}
+
+	negated := make([]string, len(r.guards))
+	for i, guard := range r.guards {
+		negated[i] = strings.Replace(guard, " != nil", " == nil", 1)
+	}
+
+	return fmt.Sprintf("(%s || %s)", strings.Join(negated, " || "), check), imports
+}
+
+// usesRoot reports whether the field is reached from root.
+func (r fieldRef) usesRoot() bool {
+	return strings.HasPrefix(r.expr, "root.")
+}
+
+// fieldRefs are the fields referenced by a conditional rule.
+type fieldRefs []fieldRef
+
+// Any returns the condition reporting whether any of the fields is set, or unset with set false,
+// and the imports of the condition.
+func (r fieldRefs) Any(set bool) (string, []string) {
+	return r.join(set, " || ")
+}
+
+// All returns the condition reporting whether all of the fields are set, or unset with set false,
+// and the imports of the condition.
+func (r fieldRefs) All(set bool) (string, []string) {
+	return r.join(set, " && ")
+}
+
+func (r fieldRefs) join(set bool, op string) (string, []string) {
+	var (
+		checks  []string
+		imports []string
+	)
+
+	for _, ref := range r {
+		check, imps := ref.unset()
+		if set {
+			check, imps = ref.set()
+		}
+
+		checks = append(checks, check)
+		imports = appendImports(imports, imps...)
+	}
+
+	return fmt.Sprintf("(%s)", strings.Join(checks, op)), imports
+}
+
+// Paths returns the references as written in the marker.
+func (r fieldRefs) Paths() []string {
+	paths := make([]string, len(r))
+	for i, ref := range r {
+		paths[i] = ref.path
+	}
+
+	return paths
+}
+
+// Param returns the fields as the parameter of an error, listed as in the marker, e.g., FirstName LastName.
+func (r fieldRefs) Param() string {
+	return strings.Join(r.Paths(), " ")
+}
+
+// UsesRoot reports whether any of the fields is reached from root.
+func (r fieldRefs) UsesRoot() bool {
+	for _, ref := range r {
+		if ref.usesRoot() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// appendImports appends the imports that are not in imports yet.
+func appendImports(imports []string, add ...string) []string {
+	for _, imp := range add {
+		if !slices.Contains(imports, imp) {
+			imports = append(imports, imp)
+		}
+	}
+
+	return imports
+}
@@ -0,0 +1,110 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+type fileextValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	extensions []string
+	multiple   bool
+	structName string
+	ruleName   string
+	parentPath string
+}
+
+var _ validator.Validator = (*fileextValidator)(nil)
+
+const fileextKey = "%s-fileext"
+
+func (f *fileextValidator) Validate() string {
+	return fileCheck("IsFileExt", f.FieldName(), f.extensions, f.multiple)
+}
+
+func (f *fileextValidator) FieldName() string {
+	return f.field.Names[0].Name
+}
+
+func (f *fileextValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(f.structName, f.parentPath, f.FieldName())
+}
+
+func (f *fileextValidator) Err() string {
+	key := fmt.Sprintf(fileextKey, f.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the extension of an uploaded file is not one of [@VALUES].
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be a file with extension [@VALUES]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUES]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", f.ErrVariable(),
+		"[@FIELD]", f.FieldName(),
+		"[@PATH]", f.FieldPath().String(),
+		"[@VALUES]", strings.Join(f.extensions, " "),
+		"[@TYPE]", f.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (f *fileextValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]FileExtValidation", "[@PATH]", f.FieldPath().CleanedPath())
+}
+
+func (f *fileextValidator) Imports() []string {
+	return []string{"github.com/templatedop/govalid/validation/validationhelper"}
+}
+
+// ValidateFileExt creates a new fileextValidator for *multipart.FileHeader and []*multipart.FileHeader
+// fields. The allowed extensions are separated by spaces, with or without the leading dot,
+// e.g., fileext=.png .jpg, and are compared ignoring case.
+func ValidateFileExt(input registry.ValidatorInput) validator.Validator {
+	multiple, ok := validatorhelper.FileHeader(input.Pass.TypesInfo.TypeOf(input.Field.Type))
+	if !ok {
+		return nil
+	}
+
+	expr, ok := input.Expressions[markers.GoValidMarkerFileext]
+	if !ok {
+		return nil
+	}
+
+	extensions := strings.Fields(expr)
+	if len(extensions) == 0 {
+		return nil
+	}
+
+	for i, ext := range extensions {
+		if !strings.HasPrefix(ext, ".") {
+			extensions[i] = "." + ext
+		}
+	}
+
+	return &fileextValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		extensions: extensions,
+		multiple:   multiple,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+}
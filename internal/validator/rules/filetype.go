@@ -0,0 +1,120 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+type filetypeValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	types      []string
+	multiple   bool
+	structName string
+	ruleName   string
+	parentPath string
+}
+
+var _ validator.Validator = (*filetypeValidator)(nil)
+
+const filetypeKey = "%s-filetype"
+
+func (f *filetypeValidator) Validate() string {
+	return fileCheck("IsFileType", f.FieldName(), f.types, f.multiple)
+}
+
+// fileCheck returns the condition failing when the named validationhelper check rejects the file,
+// or any of the files for []*multipart.FileHeader fields.
+func fileCheck(check, fieldName string, args []string, multiple bool) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		quoted[i] = strconv.Quote(arg)
+	}
+
+	if multiple {
+		return fmt.Sprintf("!validationhelper.AllFiles(t.%s, validationhelper.%s, %s)", fieldName, check, strings.Join(quoted, ", "))
+	}
+
+	return fmt.Sprintf("!validationhelper.%s(t.%s, %s)", check, fieldName, strings.Join(quoted, ", "))
+}
+
+func (f *filetypeValidator) FieldName() string {
+	return f.field.Names[0].Name
+}
+
+func (f *filetypeValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(f.structName, f.parentPath, f.FieldName())
+}
+
+func (f *filetypeValidator) Err() string {
+	key := fmt.Sprintf(filetypeKey, f.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the detected content type of an uploaded file is not one of [@VALUES].
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be a file of type [@VALUES]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUES]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", f.ErrVariable(),
+		"[@FIELD]", f.FieldName(),
+		"[@PATH]", f.FieldPath().String(),
+		"[@VALUES]", strings.Join(f.types, " "),
+		"[@TYPE]", f.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (f *filetypeValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]FileTypeValidation", "[@PATH]", f.FieldPath().CleanedPath())
+}
+
+func (f *filetypeValidator) Imports() []string {
+	return []string{"github.com/templatedop/govalid/validation/validationhelper"}
+}
+
+// ValidateFileType creates a new filetypeValidator for *multipart.FileHeader and []*multipart.FileHeader
+// fields. The allowed media types are separated by spaces, e.g., filetype=image/png image/jpeg, and
+// a type such as image/* matches every subtype.
+func ValidateFileType(input registry.ValidatorInput) validator.Validator {
+	multiple, ok := validatorhelper.FileHeader(input.Pass.TypesInfo.TypeOf(input.Field.Type))
+	if !ok {
+		return nil
+	}
+
+	expr, ok := input.Expressions[markers.GoValidMarkerFiletype]
+	if !ok {
+		return nil
+	}
+
+	types := strings.Fields(expr)
+	if len(types) == 0 {
+		return nil
+	}
+
+	return &filetypeValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		types:      types,
+		multiple:   multiple,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+}
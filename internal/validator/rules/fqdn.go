@@ -26,8 +26,7 @@ var _ validator.Validator = (*fqdnValidator)(nil)
 const fqdnKey = "%s-fqdn"
 
 func (v *fqdnValidator) Validate() string {
-	fieldName := v.FieldName()
-	return fmt.Sprintf("!validationhelper.IsValidFQDN(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidFQDN(%s)", stringField(v.pass, v.field))
 }
 
 func (v *fqdnValidator) FieldName() string {
@@ -17,7 +17,7 @@ import (
 type gtValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	gtValue    string
+	gtValue    constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*gtValidator)(nil)
 const gtKey = "%s-gt"
 
 func (m *gtValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s > %s)", m.FieldName(), m.gtValue)
+	return fmt.Sprintf("!(t.%s > %s)", m.FieldName(), m.gtValue.expr)
 }
 
 func (m *gtValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *gtValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the value of the field is less than the [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be greater than [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be greater than [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sGTValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *gtValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.gtValue,
+		"[@VALUE]", m.gtValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *gtValidator) ErrVariable() string {
 }
 
 func (m *gtValidator) Imports() []string {
-	return []string{}
+	return m.gtValue.imports
 }
 
 // ValidateGT creates a new gtValidator if the field type is numeric and the max marker is present.
@@ -101,10 +101,15 @@ func ValidateGT(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, gtValue, typ)
+	if !ok {
+		return nil
+	}
+
 	return &gtValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		gtValue:    gtValue,
+		gtValue:    value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type gtcsfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*gtcsfieldValidator)(nil)
+
+const gtcsfieldKey = "%s-gtcsfield"
+
+func (v *gtcsfieldValidator) Err() string {
+	return v.err(gtcsfieldKey, v.ErrVariable())
+}
+
+func (v *gtcsfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]GtCSFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateGtCSField creates a new gtcsfieldValidator for the given field.
+// The field must be greater than the field at a path from the validated struct, e.g., gtcsfield=Window.Start.
+func ValidateGtCSField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerGtcsfield, compareGt, true)
+	if comparison == nil {
+		return nil
+	}
+
+	return &gtcsfieldValidator{fieldComparison: comparison}
+}
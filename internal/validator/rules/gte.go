@@ -17,7 +17,7 @@ import (
 type gteValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	gteValue   string
+	gteValue   constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*gteValidator)(nil)
 const gteKey = "%s-gte"
 
 func (m *gteValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s >= %s)", m.FieldName(), m.gteValue)
+	return fmt.Sprintf("!(t.%s >= %s)", m.FieldName(), m.gteValue.expr)
 }
 
 func (m *gteValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *gteValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the value of the field is less than [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be greater than or equal to [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be greater than or equal to [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sGTEValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *gteValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.gteValue,
+		"[@VALUE]", m.gteValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *gteValidator) ErrVariable() string {
 }
 
 func (m *gteValidator) Imports() []string {
-	return []string{}
+	return m.gteValue.imports
 }
 
 // ValidateGTE creates a new gteValidator if the field type is numeric and the gte marker is present.
@@ -101,10 +101,15 @@ func ValidateGTE(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, gteValue, typ)
+	if !ok {
+		return nil
+	}
+
 	return &gteValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		gteValue:   gteValue,
+		gteValue:   value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
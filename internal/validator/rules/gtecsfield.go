@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type gtecsfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*gtecsfieldValidator)(nil)
+
+const gtecsfieldKey = "%s-gtecsfield"
+
+func (v *gtecsfieldValidator) Err() string {
+	return v.err(gtecsfieldKey, v.ErrVariable())
+}
+
+func (v *gtecsfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]GteCSFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateGteCSField creates a new gtecsfieldValidator for the given field.
+// The field must be greater than or equal to the field at a path from the validated struct, e.g., gtecsfield=Limits.Min.
+func ValidateGteCSField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerGtecsfield, compareGte, true)
+	if comparison == nil {
+		return nil
+	}
+
+	return &gtecsfieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type gtefieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*gtefieldValidator)(nil)
+
+const gtefieldKey = "%s-gtefield"
+
+func (v *gtefieldValidator) Err() string {
+	return v.err(gtefieldKey, v.ErrVariable())
+}
+
+func (v *gtefieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]GteFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateGteField creates a new gtefieldValidator for the given field.
+// The field must be greater than or equal to another field of the same struct, e.g., gtefield=MinPrice.
+func ValidateGteField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerGtefield, compareGte, false)
+	if comparison == nil {
+		return nil
+	}
+
+	return &gtefieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type gtfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*gtfieldValidator)(nil)
+
+const gtfieldKey = "%s-gtfield"
+
+func (v *gtfieldValidator) Err() string {
+	return v.err(gtfieldKey, v.ErrVariable())
+}
+
+func (v *gtfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]GtFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateGtField creates a new gtfieldValidator for the given field.
+// The field must be greater than another field of the same struct, e.g., gtfield=StartDate.
+func ValidateGtField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerGtfield, compareGt, false)
+	if comparison == nil {
+		return nil
+	}
+
+	return &gtfieldValidator{fieldComparison: comparison}
+}
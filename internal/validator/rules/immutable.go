@@ -0,0 +1,121 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type immutableValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	structName string
+	ruleName   string
+	parentPath string
+}
+
+var _ validator.TransitionValidator = (*immutableValidator)(nil)
+
+const immutableKey = "%s-immutable"
+
+func (i *immutableValidator) Validate() string {
+	return changed(i.FieldName(), i.pass.TypesInfo.TypeOf(i.field.Type))
+}
+
+// NilParentChanged implements validator.NilParentTransitionValidator: setting or clearing the struct
+// holding the field changes it.
+func (i *immutableValidator) NilParentChanged(tSet, oldSet, _ string) string {
+	return fmt.Sprintf("(%s) != (%s)", tSet, oldSet)
+}
+
+// changed returns the condition reporting whether the field differs from its previous value.
+// Comparable values are compared with !=, other values with reflect.DeepEqual, as are pointers
+// so that they are compared by the values they point to.
+func changed(name string, typ types.Type) string {
+	if _, ok := typ.Underlying().(*types.Pointer); !ok && types.Comparable(typ) {
+		return fmt.Sprintf("t.%s != old.%s", name, name)
+	}
+
+	return fmt.Sprintf("!reflect.DeepEqual(t.%s, old.%s)", name, name)
+}
+
+// changedImports returns the imports of the condition returned by changed.
+func changedImports(typ types.Type) []string {
+	if _, ok := typ.Underlying().(*types.Pointer); !ok && types.Comparable(typ) {
+		return []string{}
+	}
+
+	return []string{"reflect"}
+}
+
+func (i *immutableValidator) FieldName() string {
+	return i.field.Names[0].Name
+}
+
+func (i *immutableValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(i.structName, i.parentPath, i.FieldName())
+}
+
+func (i *immutableValidator) Err() string {
+	key := fmt.Sprintf(immutableKey, i.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the field is changed by an update.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is immutable", Path: "[@PATH]", Type: "[@TYPE]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", i.ErrVariable(),
+		"[@FIELD]", i.FieldName(),
+		"[@PATH]", i.FieldPath().String(),
+		"[@TYPE]", i.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (i *immutableValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]ImmutableValidation", "[@PATH]", i.FieldPath().CleanedPath())
+}
+
+func (i *immutableValidator) Imports() []string {
+	return changedImports(i.pass.TypesInfo.TypeOf(i.field.Type))
+}
+
+// IsTransition implements validator.TransitionValidator.
+func (i *immutableValidator) IsTransition() bool {
+	return true
+}
+
+// ValidateImmutable creates a new immutableValidator for the given field.
+// The field may not change in an update, see Validate{{Type}}Update. Fields of
+// the elements of collections validated with dive are not supported, as their
+// previous value cannot be matched, and are reported.
+func ValidateImmutable(input registry.ValidatorInput) validator.Validator {
+	if strings.Contains(input.ParentPath, "[i]") {
+		input.Report(input.Field.Pos(), "%s: not supported on dive elements, as their previous value cannot be matched", input.RuleName)
+
+		return nil
+	}
+
+	return &immutableValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+}
@@ -0,0 +1,122 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"slices"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+type immutable_once_setValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	structName string
+	ruleName   string
+	parentPath string
+}
+
+var _ validator.TransitionValidator = (*immutable_once_setValidator)(nil)
+
+const immutable_once_setKey = "%s-immutable_once_set"
+
+func (i *immutable_once_setValidator) Validate() string {
+	name := i.FieldName()
+
+	return fmt.Sprintf("%s && %s", i.set("old"), changed(name, i.pass.TypesInfo.TypeOf(i.field.Type)))
+}
+
+// NilParentChanged implements validator.NilParentTransitionValidator: clearing the struct holding
+// the field changes it if it was set, while setting the struct sets the field for the first time.
+func (i *immutable_once_setValidator) NilParentChanged(_, oldSet, old string) string {
+	return fmt.Sprintf("%s && %s", oldSet, i.set(old))
+}
+
+// set returns the condition reporting whether the field of the struct old was set.
+func (i *immutable_once_setValidator) set(old string) string {
+	name := i.FieldName()
+
+	if zero := validatorhelper.Zero(i.pass.TypesInfo.TypeOf(i.field.Type)); zero != "" {
+		return fmt.Sprintf("%s.%s != %s", old, name, zero)
+	}
+
+	return fmt.Sprintf("!reflect.ValueOf(%s.%s).IsZero()", old, name)
+}
+
+func (i *immutable_once_setValidator) FieldName() string {
+	return i.field.Names[0].Name
+}
+
+func (i *immutable_once_setValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(i.structName, i.parentPath, i.FieldName())
+}
+
+func (i *immutable_once_setValidator) Err() string {
+	key := fmt.Sprintf(immutable_once_setKey, i.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the field is changed by an update after being set.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] cannot be changed once set", Path: "[@PATH]", Type: "[@TYPE]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", i.ErrVariable(),
+		"[@FIELD]", i.FieldName(),
+		"[@PATH]", i.FieldPath().String(),
+		"[@TYPE]", i.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (i *immutable_once_setValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]ImmutableOnceSetValidation", "[@PATH]", i.FieldPath().CleanedPath())
+}
+
+func (i *immutable_once_setValidator) Imports() []string {
+	typ := i.pass.TypesInfo.TypeOf(i.field.Type)
+
+	imports := changedImports(typ)
+	if validatorhelper.Zero(typ) == "" && !slices.Contains(imports, "reflect") {
+		imports = append(imports, "reflect")
+	}
+
+	return imports
+}
+
+// IsTransition implements validator.TransitionValidator.
+func (i *immutable_once_setValidator) IsTransition() bool {
+	return true
+}
+
+// ValidateImmutableOnceSet creates a new immutable_once_setValidator for the given field.
+// The field may be set by an update while its previous value is the zero value,
+// but not changed afterwards. Like immutable, it does not apply to the fields of
+// the elements of collections validated with dive, which are reported.
+func ValidateImmutableOnceSet(input registry.ValidatorInput) validator.Validator {
+	if strings.Contains(input.ParentPath, "[i]") {
+		input.Report(input.Field.Pos(), "%s: not supported on dive elements, as their previous value cannot be matched", input.RuleName)
+
+		return nil
+	}
+
+	return &immutable_once_setValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+}
@@ -25,7 +25,7 @@ var _ validator.Validator = (*ipv4Validator)(nil)
 const ipv4Key = "%s-ipv4"
 
 func (v *ipv4Validator) Validate() string {
-	return fmt.Sprintf("ip := net.ParseIP(t.%s); ip == nil || ip.To4() == nil", v.FieldName())
+	return fmt.Sprintf("ip := net.ParseIP(%s); ip == nil || ip.To4() == nil", stringField(v.pass, v.field))
 }
 
 func (v *ipv4Validator) FieldName() string {
@@ -25,7 +25,7 @@ var _ validator.Validator = (*ipv6Validator)(nil)
 const ipv6Key = "%s-ipv6"
 
 func (v *ipv6Validator) Validate() string {
-	return fmt.Sprintf("ip := net.ParseIP(t.%s); ip == nil || ip.To4() != nil", v.FieldName())
+	return fmt.Sprintf("ip := net.ParseIP(%s); ip == nil || ip.To4() != nil", stringField(v.pass, v.field))
 }
 
 func (v *ipv6Validator) FieldName() string {
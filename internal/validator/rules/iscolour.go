@@ -26,8 +26,7 @@ var _ validator.Validator = (*iscolourValidator)(nil)
 const iscolourKey = "%s-iscolour"
 
 func (v *iscolourValidator) Validate() string {
-	fieldName := v.FieldName()
-	return fmt.Sprintf("!validationhelper.IsValidColour(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidColour(%s)", stringField(v.pass, v.field))
 }
 
 func (v *iscolourValidator) FieldName() string {
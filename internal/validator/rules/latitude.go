@@ -26,8 +26,7 @@ var _ validator.Validator = (*latitudeValidator)(nil)
 const latitudeKey = "%s-latitude"
 
 func (v *latitudeValidator) Validate() string {
-	fieldName := v.FieldName()
-	return fmt.Sprintf("!validationhelper.IsValidLatitude(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidLatitude(%s)", stringField(v.pass, v.field))
 }
 
 func (v *latitudeValidator) FieldName() string {
@@ -16,7 +16,7 @@ import (
 type lengthValidator struct {
 	pass        *codegen.Pass
 	field       *ast.Field
-	lengthValue string
+	lengthValue constArg
 	structName  string
 	ruleName    string
 	parentPath  string
@@ -27,7 +27,7 @@ var _ validator.Validator = (*lengthValidator)(nil)
 const lengthKey = "%s-length"
 
 func (l *lengthValidator) Validate() string {
-	return fmt.Sprintf("utf8.RuneCountInString(t.%s) != %s", l.FieldName(), l.lengthValue)
+	return fmt.Sprintf("utf8.RuneCountInString(%s) != %s", stringField(l.pass, l.field), l.lengthValue.expr)
 }
 
 func (l *lengthValidator) FieldName() string {
@@ -56,7 +56,7 @@ func (l *lengthValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the length of the field is not exactly [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] length must be exactly [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] length must be exactly [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sLengthValidation", l.structName, l.FieldName())
@@ -67,7 +67,7 @@ func (l *lengthValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", l.FieldName(),
 		"[@PATH]", l.FieldPath().String(),
-		"[@VALUE]", l.lengthValue,
+		"[@VALUE]", l.lengthValue.value,
 		"[@TYPE]", l.ruleName,
 	)
 
@@ -83,7 +83,7 @@ func (l *lengthValidator) ErrVariable() string {
 }
 
 func (l *lengthValidator) Imports() []string {
-	return []string{"unicode/utf8"}
+	return append([]string{"unicode/utf8"}, l.lengthValue.imports...)
 }
 
 // ValidateLength creates a new lengthValidator if the field type is string and the length marker is present.
@@ -100,10 +100,15 @@ func ValidateLength(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, lengthValue, types.Typ[types.Int])
+	if !ok {
+		return nil
+	}
+
 	return &lengthValidator{
 		pass:        input.Pass,
 		field:       input.Field,
-		lengthValue: lengthValue,
+		lengthValue: value,
 		structName:  input.StructName,
 		ruleName:    input.RuleName,
 		parentPath:  input.ParentPath,
@@ -26,8 +26,7 @@ var _ validator.Validator = (*longitudeValidator)(nil)
 const longitudeKey = "%s-longitude"
 
 func (v *longitudeValidator) Validate() string {
-	fieldName := v.FieldName()
-	return fmt.Sprintf("!validationhelper.IsValidLongitude(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidLongitude(%s)", stringField(v.pass, v.field))
 }
 
 func (v *longitudeValidator) FieldName() string {
@@ -26,8 +26,7 @@ var _ validator.Validator = (*lowercaseValidator)(nil)
 const lowercaseKey = "%s-lowercase"
 
 func (l *lowercaseValidator) Validate() string {
-	fieldName := l.FieldName()
-	return fmt.Sprintf("!validationhelper.IsLowercase(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsLowercase(%s)", stringField(l.pass, l.field))
 }
 
 func (l *lowercaseValidator) FieldName() string {
@@ -17,7 +17,7 @@ import (
 type ltValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	ltValue    string
+	ltValue    constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*ltValidator)(nil)
 const ltKey = "%s-lt"
 
 func (m *ltValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s < %s)", m.FieldName(), m.ltValue)
+	return fmt.Sprintf("!(t.%s < %s)", m.FieldName(), m.ltValue.expr)
 }
 
 func (m *ltValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *ltValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the value of the field is greater than the [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be less than [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be less than [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sLTValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *ltValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.ltValue,
+		"[@VALUE]", m.ltValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *ltValidator) ErrVariable() string {
 }
 
 func (m *ltValidator) Imports() []string {
-	return []string{}
+	return m.ltValue.imports
 }
 
 // ValidateLT creates a new ltValidator if the field type is numeric and the min marker is present.
@@ -101,10 +101,15 @@ func ValidateLT(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, ltValue, typ)
+	if !ok {
+		return nil
+	}
+
 	return &ltValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		ltValue:    ltValue,
+		ltValue:    value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
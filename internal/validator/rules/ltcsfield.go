@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type ltcsfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*ltcsfieldValidator)(nil)
+
+const ltcsfieldKey = "%s-ltcsfield"
+
+func (v *ltcsfieldValidator) Err() string {
+	return v.err(ltcsfieldKey, v.ErrVariable())
+}
+
+func (v *ltcsfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]LtCSFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateLtCSField creates a new ltcsfieldValidator for the given field.
+// The field must be less than the field at a path from the validated struct, e.g., ltcsfield=Window.End.
+func ValidateLtCSField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerLtcsfield, compareLt, true)
+	if comparison == nil {
+		return nil
+	}
+
+	return &ltcsfieldValidator{fieldComparison: comparison}
+}
@@ -17,7 +17,7 @@ import (
 type lteValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	lteValue   string
+	lteValue   constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*lteValidator)(nil)
 const lteKey = "%s-lte"
 
 func (m *lteValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s <= %s)", m.FieldName(), m.lteValue)
+	return fmt.Sprintf("!(t.%s <= %s)", m.FieldName(), m.lteValue.expr)
 }
 
 func (m *lteValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *lteValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the value of the field is greater than [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be less than or equal to [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be less than or equal to [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sLTEValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *lteValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.lteValue,
+		"[@VALUE]", m.lteValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *lteValidator) ErrVariable() string {
 }
 
 func (m *lteValidator) Imports() []string {
-	return []string{}
+	return m.lteValue.imports
 }
 
 // ValidateLTE creates a new lteValidator if the field type is numeric and the lte marker is present.
@@ -101,10 +101,15 @@ func ValidateLTE(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, lteValue, typ)
+	if !ok {
+		return nil
+	}
+
 	return &lteValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		lteValue:   lteValue,
+		lteValue:   value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
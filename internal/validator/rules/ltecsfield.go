@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type ltecsfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*ltecsfieldValidator)(nil)
+
+const ltecsfieldKey = "%s-ltecsfield"
+
+func (v *ltecsfieldValidator) Err() string {
+	return v.err(ltecsfieldKey, v.ErrVariable())
+}
+
+func (v *ltecsfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]LteCSFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateLteCSField creates a new ltecsfieldValidator for the given field.
+// The field must be less than or equal to the field at a path from the validated struct, e.g., ltecsfield=Limits.Max.
+func ValidateLteCSField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerLtecsfield, compareLte, true)
+	if comparison == nil {
+		return nil
+	}
+
+	return &ltecsfieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type ltefieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*ltefieldValidator)(nil)
+
+const ltefieldKey = "%s-ltefield"
+
+func (v *ltefieldValidator) Err() string {
+	return v.err(ltefieldKey, v.ErrVariable())
+}
+
+func (v *ltefieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]LteFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateLteField creates a new ltefieldValidator for the given field.
+// The field must be less than or equal to another field of the same struct, e.g., ltefield=MaxPrice.
+func ValidateLteField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerLtefield, compareLte, false)
+	if comparison == nil {
+		return nil
+	}
+
+	return &ltefieldValidator{fieldComparison: comparison}
+}
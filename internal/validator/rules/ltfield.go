@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type ltfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*ltfieldValidator)(nil)
+
+const ltfieldKey = "%s-ltfield"
+
+func (v *ltfieldValidator) Err() string {
+	return v.err(ltfieldKey, v.ErrVariable())
+}
+
+func (v *ltfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]LtFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateLtField creates a new ltfieldValidator for the given field.
+// The field must be less than another field of the same struct, e.g., ltfield=EndDate.
+func ValidateLtField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerLtfield, compareLt, false)
+	if comparison == nil {
+		return nil
+	}
+
+	return &ltfieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,101 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+type maxfilesValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	maxFiles   string
+	structName string
+	ruleName   string
+	parentPath string
+}
+
+var _ validator.Validator = (*maxfilesValidator)(nil)
+
+const maxfilesKey = "%s-maxfiles"
+
+func (m *maxfilesValidator) Validate() string {
+	return fmt.Sprintf("len(t.%s) > %s", m.FieldName(), m.maxFiles)
+}
+
+func (m *maxfilesValidator) FieldName() string {
+	return m.field.Names[0].Name
+}
+
+func (m *maxfilesValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(m.structName, m.parentPath, m.FieldName())
+}
+
+func (m *maxfilesValidator) Err() string {
+	key := fmt.Sprintf(maxfilesKey, m.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when more than [@VALUE] files are uploaded.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a maximum of [@VALUE] files", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", m.ErrVariable(),
+		"[@FIELD]", m.FieldName(),
+		"[@PATH]", m.FieldPath().String(),
+		"[@VALUE]", m.maxFiles,
+		"[@TYPE]", m.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (m *maxfilesValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]MaxFilesValidation", "[@PATH]", m.FieldPath().CleanedPath())
+}
+
+func (m *maxfilesValidator) Imports() []string {
+	return []string{}
+}
+
+// ValidateMaxFiles creates a new maxfilesValidator for []*multipart.FileHeader fields.
+func ValidateMaxFiles(input registry.ValidatorInput) validator.Validator {
+	multiple, ok := validatorhelper.FileHeader(input.Pass.TypesInfo.TypeOf(input.Field.Type))
+	if !ok || !multiple {
+		return nil
+	}
+
+	maxFiles, ok := input.Expressions[markers.GoValidMarkerMaxfiles]
+	if !ok {
+		return nil
+	}
+
+	maxFiles = strings.TrimSpace(maxFiles)
+	if _, err := strconv.Atoi(maxFiles); err != nil {
+		return nil
+	}
+
+	return &maxfilesValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		maxFiles:   maxFiles,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+}
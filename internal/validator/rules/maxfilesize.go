@@ -0,0 +1,119 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+	"github.com/templatedop/govalid/internal/validator/validatorhelper"
+)
+
+type maxfilesizeValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	size       string
+	bytes      int64
+	multiple   bool
+	structName string
+	ruleName   string
+	parentPath string
+}
+
+var _ validator.Validator = (*maxfilesizeValidator)(nil)
+
+const maxfilesizeKey = "%s-maxfilesize"
+
+func (m *maxfilesizeValidator) Validate() string {
+	if m.multiple {
+		return fmt.Sprintf("!validationhelper.AreFileSizesAtMost(t.%s, %d)", m.FieldName(), m.bytes)
+	}
+
+	return fmt.Sprintf("!validationhelper.IsFileSizeAtMost(t.%s, %d)", m.FieldName(), m.bytes)
+}
+
+func (m *maxfilesizeValidator) FieldName() string {
+	return m.field.Names[0].Name
+}
+
+func (m *maxfilesizeValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(m.structName, m.parentPath, m.FieldName())
+}
+
+func (m *maxfilesizeValidator) Err() string {
+	key := fmt.Sprintf(maxfilesizeKey, m.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	limit := m.size
+	if _, err := strconv.ParseInt(limit, 10, 64); err == nil {
+		limit += " bytes"
+	}
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when an uploaded file is larger than [@LIMIT].
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not exceed [@LIMIT]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", m.ErrVariable(),
+		"[@FIELD]", m.FieldName(),
+		"[@PATH]", m.FieldPath().String(),
+		"[@VALUE]", m.size,
+		"[@LIMIT]", limit,
+		"[@TYPE]", m.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (m *maxfilesizeValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]MaxFileSizeValidation", "[@PATH]", m.FieldPath().CleanedPath())
+}
+
+func (m *maxfilesizeValidator) Imports() []string {
+	return []string{"github.com/templatedop/govalid/validation/validationhelper"}
+}
+
+// ValidateMaxFileSize creates a new maxfilesizeValidator for *multipart.FileHeader and
+// []*multipart.FileHeader fields. The size accepts a unit suffix, e.g., maxfilesize=5MB, and
+// invalid sizes are reported.
+func ValidateMaxFileSize(input registry.ValidatorInput) validator.Validator {
+	multiple, ok := validatorhelper.FileHeader(input.Pass.TypesInfo.TypeOf(input.Field.Type))
+	if !ok {
+		return nil
+	}
+
+	size, ok := input.Expressions[markers.GoValidMarkerMaxfilesize]
+	if !ok {
+		return nil
+	}
+
+	bytes, err := validatorhelper.ParseByteSize(size)
+	if err != nil {
+		input.Report(input.Field.Pos(), "%s: %v", input.RuleName, err)
+
+		return nil
+	}
+
+	return &maxfilesizeValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		size:       strings.TrimSpace(size),
+		bytes:      bytes,
+		multiple:   multiple,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+}
@@ -17,7 +17,7 @@ import (
 type maxItemsValidator struct {
 	pass          *codegen.Pass
 	field         *ast.Field
-	maxItemsValue string
+	maxItemsValue constArg
 	structName    string
 	ruleName      string
 	parentPath    string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*maxItemsValidator)(nil)
 const maxItemsKey = "%s-maxitems"
 
 func (m *maxItemsValidator) Validate() string {
-	return fmt.Sprintf("len(t.%s) > %s", m.FieldName(), m.maxItemsValue)
+	return fmt.Sprintf("len(t.%s) > %s", m.FieldName(), m.maxItemsValue.expr)
 }
 
 func (m *maxItemsValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *maxItemsValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the length of the field exceeds the maximum of [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a maximum of [@VALUE] items", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a maximum of [@VALUE] items", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sMaxItemsValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *maxItemsValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.maxItemsValue,
+		"[@VALUE]", m.maxItemsValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *maxItemsValidator) ErrVariable() string {
 }
 
 func (m *maxItemsValidator) Imports() []string {
-	return []string{}
+	return m.maxItemsValue.imports
 }
 
 // ValidateMaxItems creates a new maxItemsValidator if the field type supports len() and the maxitems marker is present.
@@ -104,10 +104,15 @@ func ValidateMaxItems(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, maxItemsValue, types.Typ[types.Int])
+	if !ok {
+		return nil
+	}
+
 	return &maxItemsValidator{
 		pass:          input.Pass,
 		field:         input.Field,
-		maxItemsValue: maxItemsValue,
+		maxItemsValue: value,
 		structName:    input.StructName,
 		ruleName:      input.RuleName,
 		parentPath:    input.ParentPath,
@@ -17,7 +17,7 @@ import (
 type maxLengthValidator struct {
 	pass           *codegen.Pass
 	field          *ast.Field
-	maxLengthValue string
+	maxLengthValue constArg
 	structName     string
 	ruleName       string
 	parentPath     string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*maxLengthValidator)(nil)
 const maxLengthKey = "%s-maxlength"
 
 func (m *maxLengthValidator) Validate() string {
-	return fmt.Sprintf("utf8.RuneCountInString(t.%s) > %s", m.FieldName(), m.maxLengthValue)
+	return fmt.Sprintf("utf8.RuneCountInString(%s) > %s", stringField(m.pass, m.field), m.maxLengthValue.expr)
 }
 
 func (m *maxLengthValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *maxLengthValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the length of the field exceeds the maximum of [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a maximum length of [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a maximum length of [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sMaxLengthValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *maxLengthValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.maxLengthValue,
+		"[@VALUE]", m.maxLengthValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *maxLengthValidator) ErrVariable() string {
 }
 
 func (m *maxLengthValidator) Imports() []string {
-	return []string{"unicode/utf8"}
+	return append([]string{"unicode/utf8"}, m.maxLengthValue.imports...)
 }
 
 // ValidateMaxLength creates a new maxLengthValidator if the field type is string and the maxlength marker is present.
@@ -101,10 +101,15 @@ func ValidateMaxLength(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, maxLengthValue, types.Typ[types.Int])
+	if !ok {
+		return nil
+	}
+
 	return &maxLengthValidator{
 		pass:           input.Pass,
 		field:          input.Field,
-		maxLengthValue: maxLengthValue,
+		maxLengthValue: value,
 		structName:     input.StructName,
 		ruleName:       input.RuleName,
 		parentPath:     input.ParentPath,
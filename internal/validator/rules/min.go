@@ -17,7 +17,7 @@ import (
 type minValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	minValue   string
+	minValue   constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*minValidator)(nil)
 const minKey = "%s-min"
 
 func (m *minValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s >= %s)", m.FieldName(), m.minValue)
+	return fmt.Sprintf("!(t.%s >= %s)", m.FieldName(), m.minValue.expr)
 }
 
 func (m *minValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *minValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the value of the field is less than the minimum of [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be greater than or equal to [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be greater than or equal to [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sMinValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *minValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.minValue,
+		"[@VALUE]", m.minValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *minValidator) ErrVariable() string {
 }
 
 func (m *minValidator) Imports() []string {
-	return []string{}
+	return m.minValue.imports
 }
 
 // ValidateMin creates a new minValidator if the field type is numeric and the min marker is present.
@@ -101,10 +101,15 @@ func ValidateMin(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, minValue, typ)
+	if !ok {
+		return nil
+	}
+
 	return &minValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		minValue:   minValue,
+		minValue:   value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
@@ -17,7 +17,7 @@ import (
 type minItemsValidator struct {
 	pass          *codegen.Pass
 	field         *ast.Field
-	minItemsValue string
+	minItemsValue constArg
 	structName    string
 	ruleName      string
 	parentPath    string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*minItemsValidator)(nil)
 const minItemsKey = "%s-minitems"
 
 func (m *minItemsValidator) Validate() string {
-	return fmt.Sprintf("len(t.%s) < %s", m.FieldName(), m.minItemsValue)
+	return fmt.Sprintf("len(t.%s) < %s", m.FieldName(), m.minItemsValue.expr)
 }
 
 func (m *minItemsValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *minItemsValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the length of the field is less than the minimum of [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a minimum of [@VALUE] items", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a minimum of [@VALUE] items", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sMinItemsValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *minItemsValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.minItemsValue,
+		"[@VALUE]", m.minItemsValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *minItemsValidator) ErrVariable() string {
 }
 
 func (m *minItemsValidator) Imports() []string {
-	return []string{}
+	return m.minItemsValue.imports
 }
 
 // ValidateMinItems creates a new minItemsValidator if the field type supports len() and the minitems marker is present.
@@ -104,10 +104,15 @@ func ValidateMinItems(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, minItemsValue, types.Typ[types.Int])
+	if !ok {
+		return nil
+	}
+
 	return &minItemsValidator{
 		pass:          input.Pass,
 		field:         input.Field,
-		minItemsValue: minItemsValue,
+		minItemsValue: value,
 		structName:    input.StructName,
 		ruleName:      input.RuleName,
 		parentPath:    input.ParentPath,
@@ -17,7 +17,7 @@ import (
 type minLengthValidator struct {
 	pass           *codegen.Pass
 	field          *ast.Field
-	minLengthValue string
+	minLengthValue constArg
 	structName     string
 	ruleName       string
 	parentPath     string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*minLengthValidator)(nil)
 const minLengthKey = "%s-minlength"
 
 func (m *minLengthValidator) Validate() string {
-	return fmt.Sprintf("utf8.RuneCountInString(t.%s) < %s", m.FieldName(), m.minLengthValue)
+	return fmt.Sprintf("utf8.RuneCountInString(%s) < %s", stringField(m.pass, m.field), m.minLengthValue.expr)
 }
 
 func (m *minLengthValidator) FieldName() string {
@@ -57,7 +57,7 @@ func (m *minLengthValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the length of the field is less than the minimum of [@VALUE].
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a minimum length of [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must have a minimum length of [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sMinLengthValidation", m.structName, m.FieldName())
@@ -68,7 +68,7 @@ func (m *minLengthValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", m.FieldName(),
 		"[@PATH]", m.FieldPath().String(),
-		"[@VALUE]", m.minLengthValue,
+		"[@VALUE]", m.minLengthValue.value,
 		"[@TYPE]", m.ruleName,
 	)
 
@@ -84,7 +84,7 @@ func (m *minLengthValidator) ErrVariable() string {
 }
 
 func (m *minLengthValidator) Imports() []string {
-	return []string{"unicode/utf8"}
+	return append([]string{"unicode/utf8"}, m.minLengthValue.imports...)
 }
 
 // ValidateMinLength creates a new minLengthValidator if the field type is string and the minlength marker is present.
@@ -101,10 +101,15 @@ func ValidateMinLength(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	value, ok := constantArg(input, minLengthValue, types.Typ[types.Int])
+	if !ok {
+		return nil
+	}
+
 	return &minLengthValidator{
 		pass:           input.Pass,
 		field:          input.Field,
-		minLengthValue: minLengthValue,
+		minLengthValue: value,
 		structName:     input.StructName,
 		ruleName:       input.RuleName,
 		parentPath:     input.ParentPath,
@@ -17,7 +17,7 @@ import (
 type neValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	neValue    string
+	neValue    constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,7 +28,7 @@ var _ validator.Validator = (*neValidator)(nil)
 const neKey = "%s-ne"
 
 func (n *neValidator) Validate() string {
-	return fmt.Sprintf("!(t.%s != %s)", n.FieldName(), n.neValue)
+	return fmt.Sprintf("!(t.%s != %s)", n.FieldName(), n.neValue.expr)
 }
 
 func (n *neValidator) FieldName() string {
@@ -57,14 +57,14 @@ func (n *neValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field equals [@VALUE] but should not.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not equal [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not equal [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUE]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sNeValidation", n.structName, n.FieldName())
 	currentErrVarName := n.ErrVariable()
 
 	// Escape quotes in the value for error message
-	escapedValue := strings.ReplaceAll(n.neValue, `"`, `\"`)
+	escapedValue := strings.ReplaceAll(n.neValue.value, `"`, `\"`)
 
 	replacer := strings.NewReplacer(
 		"[@ERRVARIABLE]", currentErrVarName,
@@ -87,7 +87,7 @@ func (n *neValidator) ErrVariable() string {
 }
 
 func (n *neValidator) Imports() []string {
-	return []string{}
+	return n.neValue.imports
 }
 
 // ValidateNe creates a new neValidator if the field is comparable and the ne marker is present.
@@ -104,17 +104,19 @@ func ValidateNe(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
-	// For string types, wrap the value in quotes if not already quoted
+	// For string types, wrap the value in quotes if not already quoted, unless it is the qualified name of a constant
+	var value constArg
+
 	if basic, ok := typ.Underlying().(*types.Basic); ok && basic.Kind() == types.String {
-		if !strings.HasPrefix(neValue, `"`) && !strings.HasPrefix(neValue, "`") {
-			neValue = fmt.Sprintf(`"%s"`, neValue)
-		}
+		value = stringArg(input, neValue, typ)
+	} else if value, ok = constantArg(input, neValue, typ); !ok {
+		return nil
 	}
 
 	return &neValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		neValue:    neValue,
+		neValue:    value,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
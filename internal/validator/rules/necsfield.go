@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type necsfieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*necsfieldValidator)(nil)
+
+const necsfieldKey = "%s-necsfield"
+
+func (v *necsfieldValidator) Err() string {
+	return v.err(necsfieldKey, v.ErrVariable())
+}
+
+func (v *necsfieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]NeCSFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateNeCSField creates a new necsfieldValidator for the given field.
+// The field must not be equal to the field at a path from the validated struct, e.g., necsfield=Account.ID.
+func ValidateNeCSField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerNecsfield, compareNe, true)
+	if comparison == nil {
+		return nil
+	}
+
+	return &necsfieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,37 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type nefieldValidator struct {
+	*fieldComparison
+}
+
+var _ validator.DependentValidator = (*nefieldValidator)(nil)
+
+const nefieldKey = "%s-nefield"
+
+func (v *nefieldValidator) Err() string {
+	return v.err(nefieldKey, v.ErrVariable())
+}
+
+func (v *nefieldValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]NeFieldValidation", "[@PATH]", v.FieldPath().CleanedPath())
+}
+
+// ValidateNeField creates a new nefieldValidator for the given field.
+// The field must not be equal to another field of the same struct, e.g., nefield=OldPassword.
+func ValidateNeField(input registry.ValidatorInput) validator.Validator {
+	comparison := newFieldComparison(input, markers.GoValidMarkerNefield, compareNe, false)
+	if comparison == nil {
+		return nil
+	}
+
+	return &nefieldValidator{fieldComparison: comparison}
+}
@@ -0,0 +1,88 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type not_nullValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	structName string
+	ruleName   string
+	parentPath string
+	presence   string
+}
+
+var _ validator.Validator = (*not_nullValidator)(nil)
+
+const not_nullKey = "%s-not_null"
+
+func (n *not_nullValidator) Validate() string {
+	return fmt.Sprintf("t.%s.IsNull(%q)", n.presence, n.FieldName())
+}
+
+func (n *not_nullValidator) FieldName() string {
+	return n.field.Names[0].Name
+}
+
+func (n *not_nullValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(n.structName, n.parentPath, n.FieldName())
+}
+
+func (n *not_nullValidator) Err() string {
+	key := fmt.Sprintf(not_nullKey, n.FieldPath().CleanedPath())
+
+	if validator.GeneratorMemory[key] {
+		return ""
+	}
+
+	validator.GeneratorMemory[key] = true
+
+	const errTemplate = `
+		// [@ERRVARIABLE] is the error returned when the field is null in the payload.
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must not be null", Path: "[@PATH]", Type: "[@TYPE]"}
+	`
+
+	replacer := strings.NewReplacer(
+		"[@ERRVARIABLE]", n.ErrVariable(),
+		"[@FIELD]", n.FieldName(),
+		"[@PATH]", n.FieldPath().String(),
+		"[@TYPE]", n.ruleName,
+	)
+
+	return replacer.Replace(errTemplate)
+}
+
+func (n *not_nullValidator) ErrVariable() string {
+	return strings.ReplaceAll("Err[@PATH]NotNullValidation", "[@PATH]", n.FieldPath().CleanedPath())
+}
+
+func (n *not_nullValidator) Imports() []string {
+	return []string{}
+}
+
+// ValidateNotNull creates a new not_nullValidator for the given field.
+// It only applies to fields of structs marked with +govalid:presence, as
+// telling an explicit null from a missing field requires the recorded presence.
+func ValidateNotNull(input registry.ValidatorInput) validator.Validator {
+	if input.Presence == "" {
+		return nil
+	}
+
+	return &not_nullValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+		presence:   input.Presence,
+	}
+}
@@ -26,8 +26,7 @@ var _ validator.Validator = (*numberValidator)(nil)
 const numberKey = "%s-number"
 
 func (n *numberValidator) Validate() string {
-	fieldName := n.FieldName()
-	return fmt.Sprintf("!validationhelper.IsNumber(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsNumber(%s)", stringField(n.pass, n.field))
 }
 
 func (n *numberValidator) FieldName() string {
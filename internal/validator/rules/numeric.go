@@ -26,7 +26,7 @@ var _ validator.Validator = (*numericValidator)(nil)
 const numericKey = "%s-numeric"
 
 func (m *numericValidator) Validate() string {
-	return fmt.Sprintf("!validationhelper.IsNumeric(t.%s)", m.FieldName())
+	return fmt.Sprintf("!validationhelper.IsNumeric(%s)", stringField(m.pass, m.field))
 }
 
 func (m *numericValidator) FieldName() string {
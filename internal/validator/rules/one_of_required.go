@@ -0,0 +1,46 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"strings"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+type one_of_requiredValidator struct {
+	*fieldGroup
+}
+
+var _ validator.DependentValidator = (*one_of_requiredValidator)(nil)
+
+const one_of_requiredKey = "%s-one_of_required"
+
+func (v *one_of_requiredValidator) Validate() string {
+	return "!(" + strings.Join(v.checks, " || ") + ")"
+}
+
+func (v *one_of_requiredValidator) Err() string {
+	return v.err(one_of_requiredKey, v.ErrVariable(), "at least one of [@FIELDS] is required")
+}
+
+func (v *one_of_requiredValidator) ErrVariable() string {
+	return v.errVariable("OneOfRequired")
+}
+
+func (v *one_of_requiredValidator) Imports() []string {
+	return v.fieldGroup.Imports()
+}
+
+// ValidateOneOfRequired creates a new one_of_requiredValidator for the struct type declaring the marker,
+// e.g., +govalid:one_of_required=CardToken BankAccount Wallet.
+// At least one of the fields must be set.
+func ValidateOneOfRequired(input registry.ValidatorInput) validator.Validator {
+	group := newFieldGroup(input, markers.GoValidMarkerOne_of_required)
+	if group == nil {
+		return nil
+	}
+
+	return &one_of_requiredValidator{fieldGroup: group}
+}
@@ -17,7 +17,7 @@ import (
 type oneofValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	values     string
+	values     []constArg
 	structName string
 	ruleName   string
 	parentPath string
@@ -28,28 +28,17 @@ var _ validator.Validator = (*oneofValidator)(nil)
 const oneofKey = "%s-oneof"
 
 func (o *oneofValidator) Validate() string {
-	fieldName := o.FieldName()
-	typ := o.pass.TypesInfo.TypeOf(o.field.Type)
+	conditions := make([]string, 0, len(o.values))
 
 	// Generate a validation that checks if the value is in the list
-	values := strings.Split(o.values, " ")
-	var conditions []string
-	for _, v := range values {
-		v = strings.TrimSpace(v)
-		if v == "" {
-			continue
-		}
-		// Only wrap in quotes for string types
-		if basic, ok := typ.Underlying().(*types.Basic); ok && basic.Kind() == types.String {
-			if !strings.HasPrefix(v, `"`) && !strings.HasPrefix(v, "`") {
-				v = fmt.Sprintf(`"%s"`, v)
-			}
-		}
-		conditions = append(conditions, fmt.Sprintf("t.%s == %s", fieldName, v))
+	for _, v := range o.values {
+		conditions = append(conditions, fmt.Sprintf("t.%s == %s", o.FieldName(), v.expr))
 	}
+
 	if len(conditions) == 0 {
 		return "false"
 	}
+
 	return fmt.Sprintf("!(%s)", strings.Join(conditions, " || "))
 }
 
@@ -79,7 +68,7 @@ func (o *oneofValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field is not one of the allowed values.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be one of [@VALUES]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] must be one of [@VALUES]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@VALUES]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sOneofValidation", o.structName, o.FieldName())
@@ -90,7 +79,7 @@ func (o *oneofValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", o.FieldName(),
 		"[@PATH]", o.FieldPath().String(),
-		"[@VALUES]", o.values,
+		"[@VALUES]", o.params(),
 		"[@TYPE]", o.ruleName,
 	)
 
@@ -106,7 +95,24 @@ func (o *oneofValidator) ErrVariable() string {
 }
 
 func (o *oneofValidator) Imports() []string {
-	return []string{}
+	var imports []string
+
+	for _, v := range o.values {
+		imports = appendImports(imports, v.imports...)
+	}
+
+	return imports
+}
+
+// params returns the allowed values for the error messages.
+func (o *oneofValidator) params() string {
+	params := make([]string, 0, len(o.values))
+
+	for _, v := range o.values {
+		params = append(params, v.param())
+	}
+
+	return strings.Join(params, " ")
 }
 
 // ValidateOneof creates a new oneofValidator for string/numeric types.
@@ -124,10 +130,28 @@ func ValidateOneof(input registry.ValidatorInput) validator.Validator {
 		return nil
 	}
 
+	// Only wrap in quotes for string types, where bare words are strings and qualified names may name constants
+	args := make([]constArg, 0)
+
+	for _, v := range strings.Fields(values) {
+		if basic.Kind() == types.String {
+			args = append(args, stringArg(input, v, typ))
+
+			continue
+		}
+
+		arg, ok := constantArg(input, v, typ)
+		if !ok {
+			return nil
+		}
+
+		args = append(args, arg)
+	}
+
 	return &oneofValidator{
 		pass:       input.Pass,
 		field:      input.Field,
-		values:     values,
+		values:     args,
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
@@ -20,6 +20,8 @@ type requiredValidator struct {
 	structName string
 	ruleName   string
 	parentPath string
+	presence   string
+	nullable   bool
 }
 
 var _ validator.Validator = (*requiredValidator)(nil)
@@ -29,9 +31,30 @@ const requiredKey = "%s-required"
 func (r *requiredValidator) Validate() string {
 	typ := r.pass.TypesInfo.TypeOf(r.field.Type)
 
+	if r.presence != "" {
+		return requiredPresent(r.presence, r.FieldName(), required(r.FieldName(), typ), r.nullable)
+	}
+
 	return required(r.FieldName(), typ)
 }
 
+// requiredPresent wraps the zero value check of a field of a +govalid:presence struct.
+// Once the presence is recorded, the field must be present in the payload and, unless
+// nullable, not null; otherwise the zero value check applies.
+func requiredPresent(presence, name, zeroCheck string, nullable bool) string {
+	missing := fmt.Sprintf("!t.%s.Has(%q)", presence, name)
+	if !nullable {
+		missing = fmt.Sprintf("%s || t.%s.IsNull(%q)", missing, presence, name)
+	}
+
+	check := fmt.Sprintf("(t.%s.Recorded() && (%s))", presence, missing)
+	if zeroCheck != "" {
+		check = fmt.Sprintf("%s || (!t.%s.Recorded() && %s)", check, presence, zeroCheck)
+	}
+
+	return check
+}
+
 func required(name string, typ types.Type) string {
 	// Handle slices, maps, and channels specifically for required validation
 	switch typ.(type) {
@@ -116,5 +139,7 @@ func ValidateRequired(input registry.ValidatorInput) validator.Validator {
 		structName: input.StructName,
 		ruleName:   input.RuleName,
 		parentPath: input.ParentPath,
+		presence:   input.Presence,
+		nullable:   input.Nullable,
 	}
 }
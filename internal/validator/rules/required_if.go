@@ -15,16 +15,15 @@ import (
 )
 
 type required_ifValidator struct {
-	pass          *codegen.Pass
-	field         *ast.Field
-	otherField    string
-	expectedValue string
-	structName    string
-	ruleName      string
-	parentPath    string
+	pass       *codegen.Pass
+	field      *ast.Field
+	conditions fieldConditions
+	structName string
+	ruleName   string
+	parentPath string
 }
 
-var _ validator.Validator = (*required_ifValidator)(nil)
+var _ validator.DependentValidator = (*required_ifValidator)(nil)
 
 const required_ifKey = "%s-required_if"
 
@@ -33,9 +32,7 @@ func (r *required_ifValidator) Validate() string {
 	zero := getZeroValueForType(typ)
 	fieldName := r.FieldName()
 
-	// Generate: if otherField == expectedValue && thisField == zeroValue { fail }
-	return fmt.Sprintf("t.%s == %s && t.%s == %s",
-		r.otherField, r.expectedValue, fieldName, zero)
+	return fmt.Sprintf("%s && t.%s == %s", r.conditions.Met(), fieldName, zero)
 }
 
 func (r *required_ifValidator) FieldName() string {
@@ -64,22 +61,19 @@ func (r *required_ifValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field is required due to another field's value.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required when [@OTHER] equals [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required when [@CONDITIONS]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sRequiredIfValidation", r.structName, r.FieldName())
 	currentErrVarName := r.ErrVariable()
 
-	// Escape quotes in the value for error message
-	escapedValue := strings.ReplaceAll(r.expectedValue, `"`, `\"`)
-
 	replacer := strings.NewReplacer(
 		"[@ERRVARIABLE]", currentErrVarName,
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", r.FieldName(),
 		"[@PATH]", r.FieldPath().String(),
-		"[@OTHER]", r.otherField,
-		"[@VALUE]", escapedValue,
+		"[@CONDITIONS]", r.conditions.Describe(),
+		"[@PARAM]", r.conditions.Param(),
 		"[@TYPE]", r.ruleName,
 	)
 
@@ -95,39 +89,34 @@ func (r *required_ifValidator) ErrVariable() string {
 }
 
 func (r *required_ifValidator) Imports() []string {
-	return []string{}
+	return r.conditions.Imports()
+}
+
+// DependsOn implements validator.DependentValidator.
+func (r *required_ifValidator) DependsOn() []string {
+	return r.conditions.Fields()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (r *required_ifValidator) UsesRoot() bool {
+	return r.conditions.UsesRoot()
 }
 
 // ValidateRequiredIf creates a new required_ifValidator.
-// Format: required_if=OtherField Value
+// Format: required_if=OtherField Value [OtherField Value ...], all the pairs being ANDed
 func ValidateRequiredIf(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerRequired_if]
-	if !ok {
+	conditions := parseFieldConditions(input, markers.GoValidMarkerRequired_if)
+	if conditions == nil {
 		return nil
 	}
 
-	// Parse "FieldName Value" format
-	parts := strings.Fields(expr)
-	if len(parts) < 2 {
-		return nil
-	}
-
-	otherField := parts[0]
-	expectedValue := strings.Join(parts[1:], " ")
-
-	// Wrap value in quotes if it's not already quoted
-	if !strings.HasPrefix(expectedValue, `"`) && !strings.HasPrefix(expectedValue, "`") {
-		expectedValue = fmt.Sprintf(`"%s"`, expectedValue)
-	}
-
 	return &required_ifValidator{
-		pass:          input.Pass,
-		field:         input.Field,
-		otherField:    otherField,
-		expectedValue: expectedValue,
-		structName:    input.StructName,
-		ruleName:      input.RuleName,
-		parentPath:    input.ParentPath,
+		pass:       input.Pass,
+		field:      input.Field,
+		conditions: conditions,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
 	}
 }
 
@@ -14,16 +14,15 @@ import (
 )
 
 type required_unlessValidator struct {
-	pass          *codegen.Pass
-	field         *ast.Field
-	otherField    string
-	expectedValue string
-	structName    string
-	ruleName      string
-	parentPath    string
+	pass       *codegen.Pass
+	field      *ast.Field
+	conditions fieldConditions
+	structName string
+	ruleName   string
+	parentPath string
 }
 
-var _ validator.Validator = (*required_unlessValidator)(nil)
+var _ validator.DependentValidator = (*required_unlessValidator)(nil)
 
 const required_unlessKey = "%s-required_unless"
 
@@ -32,9 +31,7 @@ func (r *required_unlessValidator) Validate() string {
 	zero := getZeroValueForType(typ)
 	fieldName := r.FieldName()
 
-	// Generate: if otherField != expectedValue && thisField == zeroValue { fail }
-	return fmt.Sprintf("t.%s != %s && t.%s == %s",
-		r.otherField, r.expectedValue, fieldName, zero)
+	return fmt.Sprintf("%s && t.%s == %s", r.conditions.NotMet(), fieldName, zero)
 }
 
 func (r *required_unlessValidator) FieldName() string {
@@ -63,22 +60,19 @@ func (r *required_unlessValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field is required unless another field has a specific value.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required unless [@OTHER] equals [@VALUE]", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required unless [@CONDITIONS]", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sRequiredUnlessValidation", r.structName, r.FieldName())
 	currentErrVarName := r.ErrVariable()
 
-	// Escape quotes in the value for error message
-	escapedValue := strings.ReplaceAll(r.expectedValue, `"`, `\"`)
-
 	replacer := strings.NewReplacer(
 		"[@ERRVARIABLE]", currentErrVarName,
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", r.FieldName(),
 		"[@PATH]", r.FieldPath().String(),
-		"[@OTHER]", r.otherField,
-		"[@VALUE]", escapedValue,
+		"[@CONDITIONS]", r.conditions.Describe(),
+		"[@PARAM]", r.conditions.Param(),
 		"[@TYPE]", r.ruleName,
 	)
 
@@ -94,36 +88,33 @@ func (r *required_unlessValidator) ErrVariable() string {
 }
 
 func (r *required_unlessValidator) Imports() []string {
-	return []string{}
+	return r.conditions.Imports()
+}
+
+// DependsOn implements validator.DependentValidator.
+func (r *required_unlessValidator) DependsOn() []string {
+	return r.conditions.Fields()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (r *required_unlessValidator) UsesRoot() bool {
+	return r.conditions.UsesRoot()
 }
 
 // ValidateRequiredUnless creates a new required_unlessValidator.
-// Format: required_unless=OtherField Value
+// Format: required_unless=OtherField Value [OtherField Value ...], all the pairs being ANDed
 func ValidateRequiredUnless(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerRequired_unless]
-	if !ok {
+	conditions := parseFieldConditions(input, markers.GoValidMarkerRequired_unless)
+	if conditions == nil {
 		return nil
 	}
 
-	parts := strings.Fields(expr)
-	if len(parts) < 2 {
-		return nil
-	}
-
-	otherField := parts[0]
-	expectedValue := strings.Join(parts[1:], " ")
-
-	if !strings.HasPrefix(expectedValue, `"`) && !strings.HasPrefix(expectedValue, "`") {
-		expectedValue = fmt.Sprintf(`"%s"`, expectedValue)
-	}
-
 	return &required_unlessValidator{
-		pass:          input.Pass,
-		field:         input.Field,
-		otherField:    otherField,
-		expectedValue: expectedValue,
-		structName:    input.StructName,
-		ruleName:      input.RuleName,
-		parentPath:    input.ParentPath,
+		pass:       input.Pass,
+		field:      input.Field,
+		conditions: conditions,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
 	}
 }
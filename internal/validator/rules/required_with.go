@@ -16,30 +16,27 @@ import (
 type required_withValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	fields     []string
+	fields     fieldRefs
 	structName string
 	ruleName   string
 	parentPath string
 }
 
-var _ validator.Validator = (*required_withValidator)(nil)
+var _ validator.DependentValidator = (*required_withValidator)(nil)
 
 const required_withKey = "%s-required_with"
 
 func (r *required_withValidator) Validate() string {
 	typ := r.pass.TypesInfo.TypeOf(r.field.Type)
 	zero := getZeroValueForType(typ)
-	fieldName := r.FieldName()
+	condition, _ := r.condition()
 
-	// Generate: (field1 != zero || field2 != zero || ...) && thisField == zero
-	var conditions []string
-	for _, f := range r.fields {
-		// TODO: Get proper zero value for each field type - for now assume string/comparable
-		conditions = append(conditions, fmt.Sprintf(`t.%s != ""`, f))
-	}
+	return fmt.Sprintf("%s && t.%s == %s", condition, r.FieldName(), zero)
+}
 
-	return fmt.Sprintf("(%s) && t.%s == %s",
-		strings.Join(conditions, " || "), fieldName, zero)
+// condition returns the condition on the other fields under which the rule applies, and its imports.
+func (r *required_withValidator) condition() (string, []string) {
+	return r.fields.Any(true)
 }
 
 func (r *required_withValidator) FieldName() string {
@@ -68,7 +65,7 @@ func (r *required_withValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field is required because other fields are present.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required when any of [@FIELDS] are present", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required when any of [@FIELDS] are present", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sRequiredWithValidation", r.structName, r.FieldName())
@@ -79,7 +76,8 @@ func (r *required_withValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", r.FieldName(),
 		"[@PATH]", r.FieldPath().String(),
-		"[@FIELDS]", strings.Join(r.fields, ", "),
+		"[@FIELDS]", strings.Join(r.fields.Paths(), ", "),
+		"[@PARAM]", r.fields.Param(),
 		"[@TYPE]", r.ruleName,
 	)
 
@@ -95,19 +93,26 @@ func (r *required_withValidator) ErrVariable() string {
 }
 
 func (r *required_withValidator) Imports() []string {
-	return []string{}
+	_, imports := r.condition()
+
+	return imports
+}
+
+// DependsOn implements validator.DependentValidator.
+func (r *required_withValidator) DependsOn() []string {
+	return r.fields.Paths()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (r *required_withValidator) UsesRoot() bool {
+	return r.fields.UsesRoot()
 }
 
 // ValidateRequiredWith creates a new required_withValidator.
 // Format: required_with=Field1 Field2 Field3...
 func ValidateRequiredWith(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerRequired_with]
-	if !ok {
-		return nil
-	}
-
-	fields := strings.Fields(expr)
-	if len(fields) == 0 {
+	fields := parseFieldRefs(input, markers.GoValidMarkerRequired_with)
+	if fields == nil {
 		return nil
 	}
 
@@ -16,29 +16,27 @@ import (
 type required_without_allValidator struct {
 	pass       *codegen.Pass
 	field      *ast.Field
-	fields     []string
+	fields     fieldRefs
 	structName string
 	ruleName   string
 	parentPath string
 }
 
-var _ validator.Validator = (*required_without_allValidator)(nil)
+var _ validator.DependentValidator = (*required_without_allValidator)(nil)
 
 const required_without_allKey = "%s-required_without_all"
 
 func (r *required_without_allValidator) Validate() string {
 	typ := r.pass.TypesInfo.TypeOf(r.field.Type)
 	zero := getZeroValueForType(typ)
-	fieldName := r.FieldName()
+	condition, _ := r.condition()
 
-	// Generate: (field1 == zero && field2 == zero && ...) && thisField == zero
-	var conditions []string
-	for _, f := range r.fields {
-		conditions = append(conditions, fmt.Sprintf(`t.%s == ""`, f))
-	}
+	return fmt.Sprintf("%s && t.%s == %s", condition, r.FieldName(), zero)
+}
 
-	return fmt.Sprintf("(%s) && t.%s == %s",
-		strings.Join(conditions, " && "), fieldName, zero)
+// condition returns the condition on the other fields under which the rule applies, and its imports.
+func (r *required_without_allValidator) condition() (string, []string) {
+	return r.fields.All(false)
 }
 
 func (r *required_without_allValidator) FieldName() string {
@@ -67,7 +65,7 @@ func (r *required_without_allValidator) Err() string {
 
 	const errTemplate = `
 		// [@ERRVARIABLE] is the error returned when the field is required because all other fields are absent.
-		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required when all of [@FIELDS] are absent", Path: "[@PATH]", Type: "[@TYPE]"}
+		[@ERRVARIABLE] = govaliderrors.ValidationError{Reason: "field [@FIELD] is required when all of [@FIELDS] are absent", Path: "[@PATH]", Type: "[@TYPE]", Param: "[@PARAM]"}
 	`
 
 	legacyErrVarName := fmt.Sprintf("Err%s%sRequiredWithoutAllValidation", r.structName, r.FieldName())
@@ -78,7 +76,8 @@ func (r *required_without_allValidator) Err() string {
 		"[@LEGACYERRVAR]", legacyErrVarName,
 		"[@FIELD]", r.FieldName(),
 		"[@PATH]", r.FieldPath().String(),
-		"[@FIELDS]", strings.Join(r.fields, ", "),
+		"[@FIELDS]", strings.Join(r.fields.Paths(), ", "),
+		"[@PARAM]", r.fields.Param(),
 		"[@TYPE]", r.ruleName,
 	)
 
@@ -94,19 +93,26 @@ func (r *required_without_allValidator) ErrVariable() string {
 }
 
 func (r *required_without_allValidator) Imports() []string {
-	return []string{}
+	_, imports := r.condition()
+
+	return imports
+}
+
+// DependsOn implements validator.DependentValidator.
+func (r *required_without_allValidator) DependsOn() []string {
+	return r.fields.Paths()
+}
+
+// UsesRoot implements validator.RootValidator.
+func (r *required_without_allValidator) UsesRoot() bool {
+	return r.fields.UsesRoot()
 }
 
 // ValidateRequiredWithoutAll creates a new required_without_allValidator.
 // Format: required_without_all=Field1 Field2 Field3...
 func ValidateRequiredWithoutAll(input registry.ValidatorInput) validator.Validator {
-	expr, ok := input.Expressions[markers.GoValidMarkerRequired_without_all]
-	if !ok {
-		return nil
-	}
-
-	fields := strings.Fields(expr)
-	if len(fields) == 0 {
+	fields := parseFieldRefs(input, markers.GoValidMarkerRequired_without_all)
+	if fields == nil {
 		return nil
 	}
 
@@ -0,0 +1,25 @@
+package rules
+
+import (
+	"go/ast"
+	"go/types"
+
+	"github.com/gostaticanalysis/codegen"
+)
+
+// stringField returns the expression of the string field in t, converted to string when the field has
+// a named string type, such as type Email string, as the helpers validating strings take a string.
+func stringField(pass *codegen.Pass, field *ast.Field) string {
+	expr := "t." + field.Names[0].Name
+
+	typ := pass.TypesInfo.TypeOf(field.Type)
+	if typ == nil || types.Identical(typ, types.Typ[types.String]) {
+		return expr
+	}
+
+	if basic, ok := typ.Underlying().(*types.Basic); ok && basic.Kind() == types.String {
+		return "string(" + expr + ")"
+	}
+
+	return expr
+}
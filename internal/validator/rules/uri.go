@@ -26,8 +26,7 @@ var _ validator.Validator = (*uriValidator)(nil)
 const uriKey = "%s-uri"
 
 func (v *uriValidator) Validate() string {
-	fieldName := v.FieldName()
-	return fmt.Sprintf("!validationhelper.IsValidURI(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidURI(%s)", stringField(v.pass, v.field))
 }
 
 func (v *uriValidator) FieldName() string {
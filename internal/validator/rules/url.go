@@ -25,9 +25,8 @@ var _ validator.Validator = (*urlValidator)(nil)
 const urlKey = "%s-url"
 
 func (u *urlValidator) Validate() string {
-	fieldName := u.FieldName()
 	// Use external helper function for better maintainability
-	return fmt.Sprintf("!validationhelper.IsValidURL(t.%s)", fieldName)
+	return fmt.Sprintf("!validationhelper.IsValidURL(%s)", stringField(u.pass, u.field))
 }
 
 func (u *urlValidator) FieldName() string {
@@ -26,9 +26,8 @@ var _ validator.Validator = (*uuidValidator)(nil)
 const uuidKey = "%s-uuid"
 
 func (u *uuidValidator) Validate() string {
-	fieldName := u.FieldName()
 	// Generate inline manual UUID validation for maximum performance
-	return fmt.Sprintf("!isValidUUID(t.%s)", fieldName)
+	return fmt.Sprintf("!isValidUUID(%s)", stringField(u.pass, u.field))
 }
 
 func (u *uuidValidator) FieldName() string {
@@ -0,0 +1,192 @@
+// Package rules implements validation rules for fields in structs.
+package rules
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strings"
+
+	"github.com/gostaticanalysis/codegen"
+
+	"github.com/templatedop/govalid/internal/markers"
+	"github.com/templatedop/govalid/internal/validator"
+	"github.com/templatedop/govalid/internal/validator/registry"
+)
+
+// whenValidator is the guard of the other rules of a field, which only run when its condition holds.
+type whenValidator struct {
+	pass       *codegen.Pass
+	field      *ast.Field
+	structName string
+	ruleName   string
+	parentPath string
+	// condition is the Go condition under which the rules of the field run.
+	condition string
+	imports   []string
+	usesRoot  bool
+}
+
+var _ validator.GuardValidator = (*whenValidator)(nil)
+
+// whenComparison matches the simple comparisons of guards, e.g., Country==US or ..Age >= 18.
+var whenComparison = regexp.MustCompile(`^\s*([$\w.]+?)\s*(==|!=|<=|>=|<|>)\s*(.+?)\s*$`)
+
+// Validate returns the condition under which the rules of the field run.
+func (w *whenValidator) Validate() string {
+	return w.condition
+}
+
+func (w *whenValidator) FieldName() string {
+	return w.field.Names[0].Name
+}
+
+func (w *whenValidator) FieldPath() validator.FieldPath {
+	return validator.NewFieldPath(w.structName, w.parentPath, w.FieldName())
+}
+
+// Err returns no error variable, as guards do not report errors of their own.
+func (w *whenValidator) Err() string {
+	return ""
+}
+
+func (w *whenValidator) ErrVariable() string {
+	return ""
+}
+
+func (w *whenValidator) Imports() []string {
+	return w.imports
+}
+
+// IsGuard implements validator.GuardValidator.
+func (w *whenValidator) IsGuard() bool {
+	return true
+}
+
+// UsesRoot implements validator.RootValidator.
+func (w *whenValidator) UsesRoot() bool {
+	return w.usesRoot
+}
+
+// ValidateWhen creates a new whenValidator guarding the other rules of the field.
+// The condition is a comparison of a field, referenced as in the conditional rules, with a value
+// typed from it, e.g., when=Country==US, several comparisons being joined with &&, or else a CEL
+// expression where self is the struct holding the field, e.g., +govalid:if=self.Type == 'business'.
+// A struct validated on its own drops the rules guarded by a field of an enclosing struct it lacks.
+func ValidateWhen(input registry.ValidatorInput) validator.Validator {
+	expr, ok := input.Expressions[markers.GoValidMarkerWhen]
+	if !ok || input.Field == nil || strings.TrimSpace(expr) == "" {
+		return nil
+	}
+
+	w := &whenValidator{
+		pass:       input.Pass,
+		field:      input.Field,
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+	}
+
+	if comparisons := parseComparisons(expr); comparisons != nil {
+		return w.compare(input, comparisons)
+	}
+
+	cel := &celValidator{
+		pass:       input.Pass,
+		holder:     enclosingStruct(input.Pass, input.Field),
+		structName: input.StructName,
+		ruleName:   input.RuleName,
+		parentPath: input.ParentPath,
+		expression: expr,
+	}
+
+	condition, err := cel.convertCELToGo(expr, "")
+	if err != nil {
+		input.Report(input.Field.Pos(), "%s: %v", input.RuleName, err)
+
+		return nil
+	}
+
+	w.condition = fmt.Sprintf("(%s)", condition)
+	w.imports = cel.Imports()
+
+	return w
+}
+
+// parseComparisons returns the simple comparisons joined with && in expr, each as its field path,
+// operator and value, or nil if expr is not made of them.
+func parseComparisons(expr string) [][]string {
+	parts := strings.Split(expr, "&&")
+	comparisons := make([][]string, 0, len(parts))
+
+	for _, part := range parts {
+		match := whenComparison.FindStringSubmatch(part)
+		if match == nil || isCELReference(match[1]) || strings.Contains(match[3], "||") {
+			return nil
+		}
+
+		comparisons = append(comparisons, match[1:])
+	}
+
+	return comparisons
+}
+
+// compare sets the condition of the guard to the comparisons of the referenced fields with their
+// values, reporting a diagnostic and returning nil when they cannot be compared.
+func (w *whenValidator) compare(input registry.ValidatorInput, comparisons [][]string) validator.Validator {
+	conditions := make(fieldConditions, 0, len(comparisons))
+
+	for _, comparison := range comparisons {
+		path, op, raw := comparison[0], comparison[1], comparison[2]
+
+		if outsideStruct(input, path) {
+			// The guard cannot hold, see validator.GuardValidator
+			w.condition = ""
+
+			return w
+		}
+
+		ref, ok := resolveFieldRef(input, path)
+		if !ok {
+			return nil
+		}
+
+		cond := fieldCondition{ref: ref, op: op}
+		typ := ref.typ
+
+		if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+			typ = ptr.Elem()
+			cond.pointer = true
+		}
+
+		if op != "==" && op != "!=" && !isOrdered(typ) {
+			input.Report(input.Field.Pos(), "%s: %s of type %s is not ordered", input.RuleName, path, typ)
+
+			return nil
+		}
+
+		value, imports, ok := conditionValue(input, typ, raw)
+		if !ok {
+			input.Report(input.Field.Pos(), "%s: %s is not a valid value of %s of type %s", input.RuleName, raw, path, typ)
+
+			return nil
+		}
+
+		cond.value = value
+		w.imports = appendImports(w.imports, imports...)
+		conditions = append(conditions, cond)
+	}
+
+	w.condition = conditions.Met()
+	w.usesRoot = conditions.UsesRoot()
+
+	return w
+}
+
+// isCELReference reports whether the operand of a comparison references a CEL variable rather than a field.
+func isCELReference(operand string) bool {
+	name, _, _ := strings.Cut(operand, ".")
+
+	return name == "self" || name == "this" || name == "value"
+}
@@ -1,6 +1,17 @@
 // Package validator implements rules for validating fields.
 package validator
 
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+
+	"golang.org/x/tools/go/ast/astutil"
+)
+
 // Validator is an interface for validating fields in structs.
 type Validator interface {
 	Validate() string
@@ -11,5 +22,203 @@ type Validator interface {
 	Imports() []string
 }
 
+// DependentValidator is implemented by validators whose outcome also depends on other fields,
+// such as conditional rules. DependsOn returns the paths of those fields, relative to the struct
+// holding the field unless they start with RootRefPrefix, from the validated struct, or with one
+// more dot than the levels up to an enclosing struct, e.g., ..Country.
+type DependentValidator interface {
+	Validator
+	DependsOn() []string
+}
+
+// RootRefPrefix starts the paths of fields referenced from the validated struct, e.g., $root.Country.
+const RootRefPrefix = "$root."
+
+// TransitionValidator is implemented by validators comparing a field with its value in the previous
+// version of the struct, which the generated condition references as old. Transition rules only run
+// in the generated Validate{{Type}}Update function; IsTransition reports whether the rule is one.
+type TransitionValidator interface {
+	Validator
+	IsTransition() bool
+}
+
+// IsTransition reports whether v is a transition rule.
+func IsTransition(v Validator) bool {
+	tv, ok := Unwrap(v).(TransitionValidator)
+
+	return ok && tv.IsTransition()
+}
+
+// NilParentTransitionValidator is implemented by the transition rules of the fields of nested structs held
+// by pointers, which Validate{{Type}}Update also checks when the struct is nil in t or in old. NilParentChanged
+// returns the condition reporting an error then, given the conditions tSet and oldSet reporting whether
+// the struct is set in t and in old, at most one of them holding, and the expression old of the struct in
+// old, valid when oldSet holds.
+type NilParentTransitionValidator interface {
+	TransitionValidator
+	NilParentChanged(tSet, oldSet, old string) string
+}
+
+// NilParentChanged returns the condition of the transition rule v when the struct holding its field is
+// nil in t or in old, see NilParentTransitionValidator, or "" if v reports no error then.
+func NilParentChanged(v Validator, tSet, oldSet, old string) string {
+	nv, ok := Unwrap(v).(NilParentTransitionValidator)
+	if !ok {
+		return ""
+	}
+
+	return nv.NilParentChanged(tSet, oldSet, old)
+}
+
+// RootValidator is implemented by validators referencing the validated struct as root, such as the
+// rules of a nested struct comparing a field with a field outside of it. UsesRoot reports whether
+// the generated condition does, for the generated function to declare root.
+type RootValidator interface {
+	Validator
+	UsesRoot() bool
+}
+
+// UsesRoot reports whether the condition of v, or of one of its guards, references root.
+func UsesRoot(v Validator) bool {
+	for _, guard := range Guards(v) {
+		if UsesRoot(guard) {
+			return true
+		}
+	}
+
+	rv, ok := Unwrap(v).(RootValidator)
+
+	return ok && rv.UsesRoot()
+}
+
+// GuardValidator is implemented by the validators of guards, such as +govalid:if, whose Validate
+// returns the condition under which the other rules of the field run rather than a failing one.
+// An empty condition never holds, dropping the rules of the field.
+type GuardValidator interface {
+	Validator
+	IsGuard() bool
+}
+
+// IsGuard reports whether v is a guard.
+func IsGuard(v Validator) bool {
+	gv, ok := v.(GuardValidator)
+
+	return ok && gv.IsGuard()
+}
+
+// GuardedValidator wraps a validator whose rule only runs when all of its guards hold.
+type GuardedValidator struct {
+	Validator
+	Guards []Validator
+}
+
+// Imports returns the imports of the rule and of its guards.
+func (g *GuardedValidator) Imports() []string {
+	imports := g.Validator.Imports()
+	for _, guard := range g.Guards {
+		imports = append(imports, guard.Imports()...)
+	}
+
+	return imports
+}
+
+// Guards returns the guards of v, or nil if its rule always runs.
+func Guards(v Validator) []Validator {
+	for {
+		switch w := v.(type) {
+		case *GroupedValidator:
+			v = w.Validator
+		case *GuardedValidator:
+			return w.Guards
+		default:
+			return nil
+		}
+	}
+}
+
+// GroupedValidator wraps a validator whose rule only runs when one of its validation groups
+// is requested, in the generated Validate{{Type}}Groups function.
+type GroupedValidator struct {
+	Validator
+	Groups []string
+}
+
+// DerefValidator wraps the validator of a rule checking the value a pointer field points to, such as
+// the rules a *Email field inherits from the markers of type Email. The rule only runs when the
+// field is not nil.
+type DerefValidator struct {
+	Validator
+}
+
+// Validate returns the condition of the rule on the dereferenced field, guarded by a nil check.
+func (d *DerefValidator) Validate() string {
+	check := d.Validator.Validate()
+	if check == "" {
+		return ""
+	}
+
+	field := "t." + d.FieldName()
+
+	expr, err := parser.ParseExpr(check)
+	if err != nil {
+		return fmt.Sprintf("%s != nil && (%s)", field, check)
+	}
+
+	expr = astutil.Apply(expr, nil, func(c *astutil.Cursor) bool {
+		sel, ok := c.Node().(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != d.FieldName() {
+			return true
+		}
+
+		x, ok := sel.X.(*ast.Ident)
+		if !ok || x.Name != "t" {
+			return true
+		}
+
+		// The dereference is grouped when it is the operand of a selector, an index or a slice
+		switch c.Parent().(type) {
+		case *ast.SelectorExpr, *ast.IndexExpr, *ast.IndexListExpr, *ast.SliceExpr, *ast.TypeAssertExpr:
+			c.Replace(&ast.ParenExpr{X: &ast.StarExpr{X: sel}})
+		default:
+			c.Replace(&ast.StarExpr{X: sel})
+		}
+
+		return true
+	}).(ast.Expr)
+
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, token.NewFileSet(), expr); err != nil {
+		return fmt.Sprintf("%s != nil && (%s)", field, check)
+	}
+
+	return fmt.Sprintf("%s != nil && (%s)", field, buf.String())
+}
+
+// Unwrap returns the validator wrapped by a GroupedValidator, a GuardedValidator or a DerefValidator,
+// or v itself.
+func Unwrap(v Validator) Validator {
+	for {
+		switch w := v.(type) {
+		case *GroupedValidator:
+			v = w.Validator
+		case *GuardedValidator:
+			v = w.Validator
+		case *DerefValidator:
+			v = w.Validator
+		default:
+			return v
+		}
+	}
+}
+
+// Groups returns the validation groups of v, or nil if its rule always runs.
+func Groups(v Validator) []string {
+	if g, ok := v.(*GroupedValidator); ok {
+		return g.Groups
+	}
+
+	return nil
+}
+
 // GeneratorMemory is a map used to track the state of generated validators.
 var GeneratorMemory = map[string]bool{}
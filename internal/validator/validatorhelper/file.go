@@ -0,0 +1,78 @@
+package validatorhelper
+
+import (
+	"fmt"
+	"go/types"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// fileHeaderType is the type of the uploaded files of a multipart form.
+const fileHeaderType = "mime/multipart.FileHeader"
+
+// FileHeader reports whether typ is *multipart.FileHeader or []*multipart.FileHeader,
+// multiple reporting the latter.
+func FileHeader(typ types.Type) (multiple bool, ok bool) {
+	if slice, isSlice := typ.Underlying().(*types.Slice); isSlice {
+		return true, isFileHeaderPointer(slice.Elem())
+	}
+
+	return false, isFileHeaderPointer(typ)
+}
+
+// isFileHeaderPointer reports whether typ is *multipart.FileHeader.
+func isFileHeaderPointer(typ types.Type) bool {
+	ptr, ok := types.Unalias(typ).(*types.Pointer)
+	if !ok {
+		return false
+	}
+
+	named, ok := types.Unalias(ptr.Elem()).(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return false
+	}
+
+	return named.Obj().Pkg().Path()+"."+named.Obj().Name() == fileHeaderType
+}
+
+// byteUnits are the multipliers of the size suffixes accepted by ParseByteSize, longest first.
+var byteUnits = []struct {
+	suffix     string
+	multiplier int64
+}{
+	{"KIB", 1 << 10},
+	{"MIB", 1 << 20},
+	{"GIB", 1 << 30},
+	{"KB", 1 << 10},
+	{"MB", 1 << 20},
+	{"GB", 1 << 30},
+	{"B", 1},
+}
+
+// ParseByteSize parses a size in bytes with an optional unit suffix, e.g., "512", "100KB" or "5MB".
+// Units are case-insensitive powers of 1024; KiB, MiB and GiB are accepted as synonyms.
+func ParseByteSize(s string) (int64, error) {
+	value := strings.ToUpper(strings.TrimSpace(s))
+	multiplier := int64(1)
+
+	for _, unit := range byteUnits {
+		if number, ok := strings.CutSuffix(value, unit.suffix); ok {
+			value = strings.TrimSpace(number)
+			multiplier = unit.multiplier
+
+			break
+		}
+	}
+
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid byte size %q", s)
+	}
+
+	if n > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("byte size %q overflows int64", s)
+	}
+
+	return n * multiplier, nil
+}
@@ -0,0 +1,47 @@
+package govalid
+
+// Presence records which fields of a struct were present in a decoded JSON payload,
+// and which of them were an explicit null.
+//
+// Structs marked with +govalid:presence declare a Presence field tagged `json:"-"`,
+// which the generated UnmarshalJSON method fills in. The generated validation then
+// reads it to tell a missing field from one set to its zero value: `required` means
+// "present in the payload", `not_null` rejects an explicit null and `nullable` lets
+// a required field be null. A zero Presence, as in a value built in Go code rather
+// than decoded, falls back to comparing against the zero value.
+type Presence struct {
+	recorded bool
+	fields   map[string]bool
+}
+
+// Reset clears the recorded fields and marks the presence as recorded.
+func (p *Presence) Reset() {
+	p.recorded = true
+	p.fields = map[string]bool{}
+}
+
+// Set records that the field was present in the payload, null reporting whether its value was null.
+func (p *Presence) Set(field string, null bool) {
+	if p.fields == nil {
+		p.Reset()
+	}
+
+	p.fields[field] = null
+}
+
+// Recorded reports whether the presence was recorded by decoding a payload.
+func (p *Presence) Recorded() bool {
+	return p.recorded
+}
+
+// Has reports whether the field was present in the payload, including as an explicit null.
+func (p *Presence) Has(field string) bool {
+	_, ok := p.fields[field]
+
+	return ok
+}
+
+// IsNull reports whether the field was present in the payload with a null value.
+func (p *Presence) IsNull(field string) bool {
+	return p.fields[field]
+}
@@ -1,7 +1,11 @@
 //go:generate govalid ./marker.go
 
 package test
-import "time"
+import (
+	"time"
+
+	"github.com/templatedop/govalid"
+)
 
 type Required struct {
 	// +govalid:required
@@ -99,6 +103,22 @@ type Enum struct {
 	Priority Priority `json:"priority"`
 }
 
+// EnumStatus is validated against its constants
+type EnumStatus string
+
+const (
+	EnumStatusActive   EnumStatus = "active"
+	EnumStatusInactive EnumStatus = "inactive"
+)
+
+type EnumConst struct {
+	// +govalid:enum
+	Status EnumStatus `json:"status"`
+
+	// Constants of a type declared in another package
+	Weekday time.Weekday `validate:"enum" json:"weekday"`
+}
+
 type Email struct {
 	// +govalid:email
 	Email string `validate:"email" json:"email"`
@@ -309,6 +329,71 @@ type RequiredUnless struct {
 	ActiveField string `validate:"required_unless=Status inactive" json:"active_field"`
 }
 
+type RequiredIfTyped struct {
+	Count  int
+	Active bool
+	Level  RequiredIfLevel
+
+	// +govalid:required_if=Count 3
+	Reason string
+
+	// +govalid:required_if=Active true Level RequiredIfLevelHigh
+	Owner string `validate:"required_unless=Active false"`
+}
+
+type RequiredIfLevel int
+
+const (
+	RequiredIfLevelLow RequiredIfLevel = iota
+	RequiredIfLevelHigh
+)
+
+type ConditionalPaths struct {
+	Country string
+	Express bool
+
+	// +govalid:dive
+	Address ConditionalPathsAddress
+
+	// +govalid:dive
+	Items []ConditionalPathsItem
+}
+
+type ConditionalPathsAddress struct {
+	Street string
+
+	// +govalid:required_if=..Country US
+	Zip string
+}
+
+type ConditionalPathsItem struct {
+	// +govalid:required_with=$root.Address.Street
+	Label string
+
+	Insurance string `validate:"excluded_if=$root.Express false"`
+}
+
+type Guarded struct {
+	Country string
+	Type    string
+	Age     int
+
+	Zip string `validate:"when=Country==US,len=5,numeric"`
+
+	// +govalid:if=self.Type == 'business' && self.Age >= 18
+	// +govalid:required
+	Company string
+
+	// +govalid:dive
+	Referees []GuardedReferee
+}
+
+type GuardedReferee struct {
+	// +govalid:when=$root.Type==business
+	// +govalid:email
+	Email string
+}
+
 type RequiredWith struct {
 	Email string
 
@@ -384,3 +469,298 @@ type ExcludedWithoutAll struct {
 	// +govalid:excluded_without_all=FeatureA FeatureB
 	ConflictingFeature string `validate:"excluded_without_all=FeatureA FeatureB" json:"conflicting_feature"`
 }
+
+type Binder struct {
+	// +govalid:required
+	Org string `path:"org"`
+
+	// +govalid:lte=100
+	Limit int `query:"limit"`
+
+	Active bool `query:"active"`
+
+	Timeout time.Duration `query:"timeout"`
+
+	IDs []int64 `query:"id"`
+
+	Since *int `query:"since"`
+}
+
+// +govalid:presence
+type Presence struct {
+	Presence govalid.Presence `json:"-"`
+
+	// +govalid:required
+	Quantity int `json:"quantity"`
+
+	// +govalid:required
+	// +govalid:nullable
+	Discount *int `json:"discount"`
+
+	// +govalid:not_null
+	Note *string `json:"note"`
+}
+
+// +govalid:fields
+type PartialUpdate struct {
+	// +govalid:required
+	Name string `json:"name"`
+
+	// +govalid:email
+	Email string `json:"email"`
+
+	Status string `json:"status"`
+
+	// +govalid:required_if=Status active
+	ActivatedBy string `json:"activated_by"`
+}
+
+type Volume struct {
+	// +govalid:required
+	// +govalid:immutable
+	ID string
+
+	// +govalid:immutable_once_set
+	Node string
+
+	// +govalid:cel=value >= oldSelf
+	Generation int
+
+	// +govalid:immutable
+	Labels map[string]string
+
+	// +govalid:dive
+	Attachment *VolumeAttachment
+}
+
+type VolumeAttachment struct {
+	// +govalid:immutable
+	Host string
+
+	// +govalid:immutable_once_set
+	Device string
+}
+
+type Membership struct {
+	// +govalid:required;groups=update
+	ID string
+
+	// +govalid:email
+	Email string
+
+	Password string `validate:"required;groups=create,min=8;groups=create update"`
+}
+
+// +govalid:cel=self.Start < self.End
+// +govalid:required;each
+type DateRange struct {
+	Start int
+	End   int
+}
+
+// +govalid:exactly_one_of=CardToken BankAccount Wallet
+// +govalid:at_most_one_of=Coupon GiftCard
+// +govalid:all_or_none_of=Street City
+// +govalid:one_of_required=Email Phone
+type Checkout struct {
+	CardToken   string
+	BankAccount *string
+	Wallet      CheckoutWallet
+
+	Coupon   string
+	GiftCard string
+
+	Street string
+	City   string
+
+	Email string
+	Phone string
+}
+
+type CheckoutWallet struct {
+	Provider string
+}
+
+type FieldComparison struct {
+	Password string
+	// +govalid:eqfield=Password
+	ConfirmPassword string
+
+	CurrentPin string
+	// +govalid:nefield=CurrentPin
+	NewPin string
+
+	Opens time.Time
+	// +govalid:gtfield=Opens
+	Closes time.Time
+
+	MinAge int
+	// +govalid:gtefield=MinAge
+	MaxAge int
+
+	Timeout time.Duration
+	// +govalid:ltfield=Timeout
+	Retry time.Duration
+
+	Capacity uint
+	// +govalid:ltefield=Capacity
+	Booked uint
+
+	Email *string
+	// +govalid:eqfield=Email
+	ConfirmEmail *string
+
+	// +govalid:dive
+	Slots []FieldComparisonSlot
+}
+
+type FieldComparisonSlot struct {
+	// +govalid:gtecsfield=Opens
+	// +govalid:ltecsfield=Closes
+	At time.Time
+
+	Code string `validate:"necsfield=Password"`
+}
+
+// NamedEmail is validated wherever it is used
+// +govalid:email
+type NamedEmail string
+
+// +govalid:minitems=1
+// +govalid:maxitems=3
+type NamedTags []string
+
+type NamedTypes struct {
+	Email NamedEmail `json:"email"`
+
+	// +govalid:maxitems=2
+	Tags NamedTags `json:"tags"`
+
+	BackupEmail *NamedEmail `json:"backup_email"`
+}
+
+// Limits referenced by the markers of ConstArgs
+const (
+	ConstArgsMaxNameLen = 5
+	ConstArgsMaxRetries = 3
+)
+
+type ConstArgs struct {
+	// +govalid:maxlength=ConstArgsMaxNameLen
+	Name string `json:"name"`
+
+	// +govalid:lte=ConstArgsMaxRetries
+	Retries int `json:"retries"`
+
+	// The constants of time are referenced, other words are strings
+	// +govalid:oneof=time.RFC3339 time.Kitchen iso
+	Layout string `json:"layout"`
+}
+
+// +govalid:expr=self.Until.IsZero() || self.From.Before(self.Until)
+type ExprHeaders struct {
+	// +govalid:expr=len(self.Tags) <= self.MaxTags && strings.HasPrefix(value, "x-")
+	Name string `json:"name"`
+
+	Tags []string `json:"tags"`
+
+	MaxTags int `json:"max_tags"`
+
+	From time.Time `json:"from"`
+
+	Until time.Time `json:"until"`
+}
+
+type CELTypedPlan string
+
+type CELTypedBooking struct {
+	// +govalid:cel=value < this.until && this.until - value <= this.max_stay
+	From time.Time `json:"from"`
+
+	Until time.Time `json:"until"`
+
+	MaxStay time.Duration `json:"max_stay"`
+
+	// +govalid:cel=value.startsWith('plan-') && value != this.Previous
+	Plan CELTypedPlan `json:"plan"`
+
+	Previous string `json:"previous"`
+
+	// +govalid:cel=value <= this.Rooms
+	Guests int32 `json:"guests"`
+
+	Rooms int `json:"rooms"`
+}
+
+// CELExtensions covers the CEL standard library and the extensions converted to Go, checked against the
+// cel-go interpreter by the conformance tests.
+type CELExtensions struct {
+	// +govalid:cel=value.lowerAscii().startsWith('ab') && value.indexOf('-') <= 3 && value.charAt(0) != 'x' && value.upperAscii() != value.lowerAscii()
+	Code string `json:"code"`
+
+	// +govalid:cel=size(value) <= 5 && value.split('-').size() <= 3 && value.split('-').join('') == value.replace('-', '') && value.split('-', 2)[0] != 'x'
+	Slug string `json:"slug"`
+
+	// +govalid:cel=value.trim() == value && value.substring(0, 1) == value.substring(0, 1).upperAscii() && value.reverse() != value && value.lastIndexOf('o') >= value.indexOf('o') && strings.quote(value).size() == size(value) + 2
+	Title string `json:"title"`
+
+	// +govalid:cel=size(value) == 0 || (lists.range(size(value)).all(i, i == 0 || value[i] != value[i - 1]) && value.distinct().size() == size(value) && value.sort().reverse()[0] >= value[0] && value.slice(0, 1) == [value[0]])
+	Tags []string `json:"tags"`
+
+	// +govalid:cel=(size(value) == 0 || (math.greatest(value) <= 100 && math.least(value) >= 0)) && value.map(s, s * 2).all(d, d <= 200) && value.filter(s, s % 2 == 1).size() <= 3 && !value.exists(s, s == 42)
+	Scores []int `json:"scores"`
+
+	// +govalid:cel=math.abs(value) <= 10 && math.sign(value) * value >= 0 && math.greatest(value, this.Base, 1) >= 1 && math.least(value, this.Base) <= this.Base && (value > 5 ? value - 5 : 0) <= 5
+	Offset int `json:"offset"`
+
+	Base int `json:"base"`
+
+	// +govalid:cel=math.isFinite(value) && math.ceil(value) - math.floor(value) <= 1.0 && math.round(value) >= math.trunc(value) - 1.0 && math.abs(value) < 1000.0
+	Ratio float64 `json:"ratio"`
+
+	// +govalid:cel=value.exists(k, k.startsWith('max')) && has(value.max_users) && value.all(k, value[k] >= 0) && value[?'min_users'].orValue(0) <= value.max_users
+	Limits map[string]int `json:"limits"`
+
+	// +govalid:cel=sets.contains(['admin', 'user', 'guest'], value) && !sets.intersects(value, ['root']) && sets.equivalent(value, value.reverse()) && value.first().orValue('') != 'guest'
+	Roles []string `json:"roles"`
+
+	// +govalid:cel=value.getFullYear() >= 2000 && value.getMonth() < 11 && value.getDayOfMonth() + 1 == value.getDate() && value.getDayOfWeek() != 0 && string(value).startsWith('20')
+	Start time.Time `json:"start"`
+
+	// +govalid:cel=value.getSeconds() <= 3600 && value.getMinutes() * 60 <= value.getSeconds() && value.getMilliseconds() % 1000 == 0
+	Timeout time.Duration `json:"timeout"`
+}
+
+// IsValidVAT reports whether number is a VAT identification number: a country code of two upper case
+// letters followed by 2 to 13 digits or upper case letters.
+//
+// +govalid:celfunc=isValidVAT
+func IsValidVAT(number string) bool {
+	if len(number) < 4 || len(number) > 15 {
+		return false
+	}
+
+	for i, r := range number {
+		if (r < 'A' || r > 'Z') && (i < 2 || r < '0' || r > '9') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// +govalid:celfunc
+func withinQuota(used, quota int) bool {
+	return used <= quota
+}
+
+// CELFunctions uses the custom CEL functions bound to Go functions with +govalid:celfunc.
+type CELFunctions struct {
+	// +govalid:cel=isValidVAT(value) && !value.startsWith('XX')
+	VATNumber string `json:"vat_number"`
+
+	// +govalid:cel=withinQuota(value, this.Quota)
+	Used int `json:"used"`
+
+	Quota int `json:"quota"`
+}
@@ -0,0 +1,189 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilBinder is returned when the Binder is nil.
+	ErrNilBinder = errors.New("input Binder is nil")
+
+	// ErrBinderOrgRequiredValidation is returned when the Org is required but not provided.
+	ErrBinderOrgRequiredValidation = govaliderrors.ValidationError{Reason: "field Org is required", Path: "Binder.Org", Type: "required"}
+
+	// ErrBinderLimitLTEValidation is the error returned when the value of the field is greater than 100.
+	ErrBinderLimitLTEValidation = govaliderrors.ValidationError{Reason: "field Limit must be less than or equal to 100", Path: "Binder.Limit", Type: "lte", Param: "100"}
+
+	// ErrBinderLimitConversionValidation is the error returned when the value of the field cannot be converted to int.
+	ErrBinderLimitConversionValidation = govaliderrors.ValidationError{Reason: "field Limit must be a valid int", Path: "Binder.Limit", Type: "conversion", Param: "int"}
+
+	// ErrBinderActiveConversionValidation is the error returned when the value of the field cannot be converted to bool.
+	ErrBinderActiveConversionValidation = govaliderrors.ValidationError{Reason: "field Active must be a valid bool", Path: "Binder.Active", Type: "conversion", Param: "bool"}
+
+	// ErrBinderTimeoutConversionValidation is the error returned when the value of the field cannot be converted to duration.
+	ErrBinderTimeoutConversionValidation = govaliderrors.ValidationError{Reason: "field Timeout must be a valid duration", Path: "Binder.Timeout", Type: "conversion", Param: "duration"}
+
+	// ErrBinderIDsConversionValidation is the error returned when the value of the field cannot be converted to int64.
+	ErrBinderIDsConversionValidation = govaliderrors.ValidationError{Reason: "field IDs must be a valid int64", Path: "Binder.IDs", Type: "conversion", Param: "int64"}
+
+	// ErrBinderSinceConversionValidation is the error returned when the value of the field cannot be converted to int.
+	ErrBinderSinceConversionValidation = govaliderrors.ValidationError{Reason: "field Since must be a valid int", Path: "Binder.Since", Type: "conversion", Param: "int"}
+)
+
+func ValidateBinder(t *Binder) error {
+	if t == nil {
+		return ErrNilBinder
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.Org == "" {
+		err := ErrBinderOrgRequiredValidation
+		err.Value = t.Org
+		errs = append(errs, err)
+	}
+
+	if !(t.Limit <= 100) {
+		err := ErrBinderLimitLTEValidation
+		err.Value = t.Limit
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*Binder)(nil)
+
+func (t *Binder) Validate() error {
+	return ValidateBinder(t)
+}
+
+// bindBinderValues assigns the fields of t from values, converting each value to its field type.
+func bindBinderValues(t *Binder, values url.Values) govaliderrors.ValidationErrors {
+	var errs govaliderrors.ValidationErrors
+
+	if raw, ok := values["org"]; ok && len(raw) > 0 {
+		s := raw[0]
+		t.Org = s
+	}
+
+	if raw, ok := values["limit"]; ok && len(raw) > 0 {
+		s := raw[0]
+		if s != "" {
+			v, err := strconv.ParseInt(s, 10, 0)
+			if err != nil {
+				verr := ErrBinderLimitConversionValidation
+				verr.Value = s
+				errs = append(errs, verr)
+			} else {
+				t.Limit = int(v)
+			}
+		}
+	}
+
+	if raw, ok := values["active"]; ok && len(raw) > 0 {
+		s := raw[0]
+		if s != "" {
+			v, err := strconv.ParseBool(s)
+			if err != nil {
+				verr := ErrBinderActiveConversionValidation
+				verr.Value = s
+				errs = append(errs, verr)
+			} else {
+				t.Active = v
+			}
+		}
+	}
+
+	if raw, ok := values["timeout"]; ok && len(raw) > 0 {
+		s := raw[0]
+		if s != "" {
+			v, err := time.ParseDuration(s)
+			if err != nil {
+				verr := ErrBinderTimeoutConversionValidation
+				verr.Value = s
+				errs = append(errs, verr)
+			} else {
+				t.Timeout = v
+			}
+		}
+	}
+
+	if raw, ok := values["id"]; ok {
+		t.IDs = make([]int64, 0, len(raw))
+		for _, s := range raw {
+			if s != "" {
+				v, err := strconv.ParseInt(s, 10, 64)
+				if err != nil {
+					verr := ErrBinderIDsConversionValidation
+					verr.Value = s
+					errs = append(errs, verr)
+					continue
+				}
+				t.IDs = append(t.IDs, v)
+			}
+		}
+	}
+
+	if raw, ok := values["since"]; ok && len(raw) > 0 {
+		s := raw[0]
+		if s != "" {
+			v, err := strconv.ParseInt(s, 10, 0)
+			if err != nil {
+				verr := ErrBinderSinceConversionValidation
+				verr.Value = s
+				errs = append(errs, verr)
+			} else {
+				value := int(v)
+				t.Since = &value
+			}
+		}
+	}
+
+	return errs
+}
+
+// DecodeValues assigns the fields of t from values, reporting values that cannot be converted
+// to their field type as ValidationErrors. It does not run the validation rules.
+func (t *Binder) DecodeValues(values url.Values) error {
+	if errs := bindBinderValues(t, values); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// BindBinderFromValues creates a Binder from values and validates it.
+// Values that cannot be converted to their field type are reported as ValidationErrors
+// together with the violations of the validation rules.
+func BindBinderFromValues(values url.Values) (*Binder, error) {
+	t := &Binder{}
+	errs := bindBinderValues(t, values)
+
+	if err := ValidateBinder(t); err != nil {
+		var verrs govaliderrors.ValidationErrors
+		if !errors.As(err, &verrs) {
+			return t, err
+		}
+		errs = append(errs, verrs...)
+	}
+
+	if len(errs) > 0 {
+		return t, errs
+	}
+	return t, nil
+}
+
+// PathParams returns the names of the path parameters bound into Binder.
+func (t *Binder) PathParams() []string {
+	return []string{"org"}
+}
@@ -13,10 +13,10 @@ var (
 	ErrNilCELCrossField = errors.New("input CELCrossField is nil")
 
 	// ErrCELCrossFieldPriceCELValidation is the error returned when the CEL expression evaluation fails.
-	ErrCELCrossFieldPriceCELValidation = govaliderrors.ValidationError{Reason: "field Price failed CEL validation: value < this.MaxPrice", Path: "CELCrossField.Price", Type: "cel"}
+	ErrCELCrossFieldPriceCELValidation = govaliderrors.ValidationError{Reason: "field Price failed CEL validation: value < this.MaxPrice", Path: "CELCrossField.Price", Type: "cel", Param: "value < this.MaxPrice"}
 
 	// ErrCELCrossFieldQuantityCELValidation is the error returned when the CEL expression evaluation fails.
-	ErrCELCrossFieldQuantityCELValidation = govaliderrors.ValidationError{Reason: "field Quantity failed CEL validation: value * this.Price <= this.Budget", Path: "CELCrossField.Quantity", Type: "cel"}
+	ErrCELCrossFieldQuantityCELValidation = govaliderrors.ValidationError{Reason: "field Quantity failed CEL validation: value * this.Price <= this.Budget", Path: "CELCrossField.Quantity", Type: "cel", Param: "value * this.Price <= this.Budget"}
 )
 
 func ValidateCELCrossField(t *CELCrossField) error {
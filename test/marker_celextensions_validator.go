@@ -0,0 +1,316 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilCELExtensions is returned when the CELExtensions is nil.
+	ErrNilCELExtensions = errors.New("input CELExtensions is nil")
+
+	// ErrCELExtensionsCodeCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsCodeCELValidation = govaliderrors.ValidationError{Reason: "field Code failed CEL validation: value.lowerAscii().startsWith('ab') && value.indexOf('-') <= 3 && value.charAt(0) != 'x' && value.upperAscii() != value.lowerAscii()", Path: "CELExtensions.Code", Type: "cel", Param: "value.lowerAscii().startsWith('ab') && value.indexOf('-') <= 3 && value.charAt(0) != 'x' && value.upperAscii() != value.lowerAscii()"}
+
+	// ErrCELExtensionsSlugCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsSlugCELValidation = govaliderrors.ValidationError{Reason: "field Slug failed CEL validation: size(value) <= 5 && value.split('-').size() <= 3 && value.split('-').join('') == value.replace('-', '') && value.split('-', 2)[0] != 'x'", Path: "CELExtensions.Slug", Type: "cel", Param: "size(value) <= 5 && value.split('-').size() <= 3 && value.split('-').join('') == value.replace('-', '') && value.split('-', 2)[0] != 'x'"}
+
+	// ErrCELExtensionsTitleCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsTitleCELValidation = govaliderrors.ValidationError{Reason: "field Title failed CEL validation: value.trim() == value && value.substring(0, 1) == value.substring(0, 1).upperAscii() && value.reverse() != value && value.lastIndexOf('o') >= value.indexOf('o') && strings.quote(value).size() == size(value) + 2", Path: "CELExtensions.Title", Type: "cel", Param: "value.trim() == value && value.substring(0, 1) == value.substring(0, 1).upperAscii() && value.reverse() != value && value.lastIndexOf('o') >= value.indexOf('o') && strings.quote(value).size() == size(value) + 2"}
+
+	// ErrCELExtensionsTagsCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsTagsCELValidation = govaliderrors.ValidationError{Reason: "field Tags failed CEL validation: size(value) == 0 || (lists.range(size(value)).all(i, i == 0 || value[i] != value[i - 1]) && value.distinct().size() == size(value) && value.sort().reverse()[0] >= value[0] && value.slice(0, 1) == [value[0]])", Path: "CELExtensions.Tags", Type: "cel", Param: "size(value) == 0 || (lists.range(size(value)).all(i, i == 0 || value[i] != value[i - 1]) && value.distinct().size() == size(value) && value.sort().reverse()[0] >= value[0] && value.slice(0, 1) == [value[0]])"}
+
+	// ErrCELExtensionsScoresCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsScoresCELValidation = govaliderrors.ValidationError{Reason: "field Scores failed CEL validation: (size(value) == 0 || (math.greatest(value) <= 100 && math.least(value) >= 0)) && value.map(s, s * 2).all(d, d <= 200) && value.filter(s, s % 2 == 1).size() <= 3 && !value.exists(s, s == 42)", Path: "CELExtensions.Scores", Type: "cel", Param: "(size(value) == 0 || (math.greatest(value) <= 100 && math.least(value) >= 0)) && value.map(s, s * 2).all(d, d <= 200) && value.filter(s, s % 2 == 1).size() <= 3 && !value.exists(s, s == 42)"}
+
+	// ErrCELExtensionsOffsetCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsOffsetCELValidation = govaliderrors.ValidationError{Reason: "field Offset failed CEL validation: math.abs(value) <= 10 && math.sign(value) * value >= 0 && math.greatest(value, this.Base, 1) >= 1 && math.least(value, this.Base) <= this.Base && (value > 5 ? value - 5 : 0) <= 5", Path: "CELExtensions.Offset", Type: "cel", Param: "math.abs(value) <= 10 && math.sign(value) * value >= 0 && math.greatest(value, this.Base, 1) >= 1 && math.least(value, this.Base) <= this.Base && (value > 5 ? value - 5 : 0) <= 5"}
+
+	// ErrCELExtensionsRatioCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsRatioCELValidation = govaliderrors.ValidationError{Reason: "field Ratio failed CEL validation: math.isFinite(value) && math.ceil(value) - math.floor(value) <= 1.0 && math.round(value) >= math.trunc(value) - 1.0 && math.abs(value) < 1000.0", Path: "CELExtensions.Ratio", Type: "cel", Param: "math.isFinite(value) && math.ceil(value) - math.floor(value) <= 1.0 && math.round(value) >= math.trunc(value) - 1.0 && math.abs(value) < 1000.0"}
+
+	// ErrCELExtensionsLimitsCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsLimitsCELValidation = govaliderrors.ValidationError{Reason: "field Limits failed CEL validation: value.exists(k, k.startsWith('max')) && has(value.max_users) && value.all(k, value[k] >= 0) && value[?'min_users'].orValue(0) <= value.max_users", Path: "CELExtensions.Limits", Type: "cel", Param: "value.exists(k, k.startsWith('max')) && has(value.max_users) && value.all(k, value[k] >= 0) && value[?'min_users'].orValue(0) <= value.max_users"}
+
+	// ErrCELExtensionsRolesCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsRolesCELValidation = govaliderrors.ValidationError{Reason: "field Roles failed CEL validation: sets.contains(['admin', 'user', 'guest'], value) && !sets.intersects(value, ['root']) && sets.equivalent(value, value.reverse()) && value.first().orValue('') != 'guest'", Path: "CELExtensions.Roles", Type: "cel", Param: "sets.contains(['admin', 'user', 'guest'], value) && !sets.intersects(value, ['root']) && sets.equivalent(value, value.reverse()) && value.first().orValue('') != 'guest'"}
+
+	// ErrCELExtensionsStartCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsStartCELValidation = govaliderrors.ValidationError{Reason: "field Start failed CEL validation: value.getFullYear() >= 2000 && value.getMonth() < 11 && value.getDayOfMonth() + 1 == value.getDate() && value.getDayOfWeek() != 0 && string(value).startsWith('20')", Path: "CELExtensions.Start", Type: "cel", Param: "value.getFullYear() >= 2000 && value.getMonth() < 11 && value.getDayOfMonth() + 1 == value.getDate() && value.getDayOfWeek() != 0 && string(value).startsWith('20')"}
+
+	// ErrCELExtensionsTimeoutCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELExtensionsTimeoutCELValidation = govaliderrors.ValidationError{Reason: "field Timeout failed CEL validation: value.getSeconds() <= 3600 && value.getMinutes() * 60 <= value.getSeconds() && value.getMilliseconds() % 1000 == 0", Path: "CELExtensions.Timeout", Type: "cel", Param: "value.getSeconds() <= 3600 && value.getMinutes() * 60 <= value.getSeconds() && value.getMilliseconds() % 1000 == 0"}
+)
+
+func ValidateCELExtensions(t *CELExtensions) error {
+	if t == nil {
+		return ErrNilCELExtensions
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !(((strings.HasPrefix(strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, t.Code), "ab")) && (func(s, sub string) int64 {
+		i := strings.Index(s, sub)
+		if i < 0 {
+			return -1
+		}
+		return int64(utf8.RuneCountInString(s[:i]))
+	}(t.Code, "-") <= 3)) && ((func(s string, i int64) string {
+		r := []rune(s)
+		if i < 0 || i >= int64(len(r)) {
+			return ""
+		}
+		return string(r[i])
+	}(t.Code, 0) != "x") && (strings.Map(func(r rune) rune {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, t.Code) != strings.Map(func(r rune) rune {
+		if 'A' <= r && r <= 'Z' {
+			return r + 'a' - 'A'
+		}
+		return r
+	}, t.Code)))) {
+		err := ErrCELExtensionsCodeCELValidation
+		err.Value = t.Code
+		errs = append(errs, err)
+	}
+
+	if !(((utf8.RuneCountInString(t.Slug) <= 5) && (len(strings.Split(t.Slug, "-")) <= 3)) && ((strings.Join(strings.Split(t.Slug, "-"), "") == strings.ReplaceAll(t.Slug, "-", "")) && (strings.SplitN(t.Slug, "-", int(2))[0] != "x"))) {
+		err := ErrCELExtensionsSlugCELValidation
+		err.Value = t.Slug
+		errs = append(errs, err)
+	}
+
+	if !((((strings.TrimSpace(t.Title) == t.Title) && (func(s string, start, end int64) string {
+		r := []rune(s)
+		if start < 0 || end < start || end > int64(len(r)) {
+			return ""
+		}
+		return string(r[start:end])
+	}(t.Title, 0, 1) == strings.Map(func(r rune) rune {
+		if 'a' <= r && r <= 'z' {
+			return r - 'a' + 'A'
+		}
+		return r
+	}, func(s string, start, end int64) string {
+		r := []rune(s)
+		if start < 0 || end < start || end > int64(len(r)) {
+			return ""
+		}
+		return string(r[start:end])
+	}(t.Title, 0, 1)))) && (func(s string) string { r := []rune(s); slices.Reverse(r); return string(r) }(t.Title) != t.Title)) && ((func(s, sub string) int64 {
+		i := strings.LastIndex(s, sub)
+		if i < 0 {
+			return -1
+		}
+		return int64(utf8.RuneCountInString(s[:i]))
+	}(t.Title, "o") >= func(s, sub string) int64 {
+		i := strings.Index(s, sub)
+		if i < 0 {
+			return -1
+		}
+		return int64(utf8.RuneCountInString(s[:i]))
+	}(t.Title, "o")) && (utf8.RuneCountInString(strconv.Quote(t.Title)) == utf8.RuneCountInString(t.Title)+2))) {
+		err := ErrCELExtensionsTitleCELValidation
+		err.Value = t.Title
+		errs = append(errs, err)
+	}
+
+	if !((len(t.Tags) == 0) || (((func() bool {
+		for _, i := range func(n int64) []int64 {
+			var r []int64
+			for i := int64(0); i < n; i++ {
+				r = append(r, i)
+			}
+			return r
+		}(int64(len(t.Tags))) {
+			if !((i == 0) || (t.Tags[i] != t.Tags[i-1])) {
+				return false
+			}
+		}
+		return true
+	}()) && (len(func(l []string) []string {
+		var r []string
+		for _, v := range l {
+			if !slices.Contains(r, v) {
+				r = append(r, v)
+			}
+		}
+		return r
+	}(t.Tags)) == len(t.Tags))) && ((func(l []string) []string { r := slices.Clone(l); slices.Reverse(r); return r }(func(l []string) []string { r := slices.Clone(l); slices.Sort(r); return r }(t.Tags))[0] >= t.Tags[0]) && (slices.Equal(func(l []string, start, end int64) []string {
+		if start < 0 || end < start || end > int64(len(l)) {
+			return nil
+		}
+		return l[start:end]
+	}(t.Tags, 0, 1), []string{t.Tags[0]}))))) {
+		err := ErrCELExtensionsTagsCELValidation
+		err.Value = t.Tags
+		errs = append(errs, err)
+	}
+
+	if !((((len(t.Scores) == 0) || ((slices.Max(t.Scores) <= 100) && (slices.Min(t.Scores) >= 0))) && (func() bool {
+		for _, d := range func() []int {
+			var result []int
+			for _, s := range t.Scores {
+				result = append(result, s*2)
+			}
+			return result
+		}() {
+			if !(d <= 200) {
+				return false
+			}
+		}
+		return true
+	}())) && ((len(func() []int {
+		var result []int
+		for _, s := range t.Scores {
+			if s%2 == 1 {
+				result = append(result, s)
+			}
+		}
+		return result
+	}()) <= 3) && (!(func() bool {
+		for _, s := range t.Scores {
+			if s == 42 {
+				return true
+			}
+		}
+		return false
+	}())))) {
+		err := ErrCELExtensionsScoresCELValidation
+		err.Value = t.Scores
+		errs = append(errs, err)
+	}
+
+	if !((((func(x int64) int64 {
+		if x < 0 {
+			return -x
+		}
+		return x
+	}(int64(t.Offset)) <= 10) && (int64(func(x int64) int64 {
+		switch {
+		case x > 0:
+			return 1
+		case x < 0:
+			return -1
+		}
+		return 0
+	}(int64(t.Offset)))*int64(t.Offset) >= 0)) && (max(int64(t.Offset), int64(t.Base), 1) >= 1)) && ((int64(min(int64(t.Offset), int64(t.Base))) <= int64(t.Base)) && (func() int {
+		if t.Offset > 5 {
+			return t.Offset - 5
+		}
+		return 0
+	}() <= 5))) {
+		err := ErrCELExtensionsOffsetCELValidation
+		err.Value = t.Offset
+		errs = append(errs, err)
+	}
+
+	if !(((func(x float64) bool { return !math.IsInf(x, 0) && !math.IsNaN(x) }(t.Ratio)) && (math.Ceil(t.Ratio)-math.Floor(t.Ratio) <= 1)) && ((math.Round(t.Ratio) >= math.Trunc(t.Ratio)-1) && (math.Abs(t.Ratio) < 1000))) {
+		err := ErrCELExtensionsRatioCELValidation
+		err.Value = t.Ratio
+		errs = append(errs, err)
+	}
+
+	if !(((func() bool {
+		for k := range t.Limits {
+			if strings.HasPrefix(k, "max") {
+				return true
+			}
+		}
+		return false
+	}()) && (func() bool { _, ok := t.Limits["max_users"]; return ok }())) && ((func() bool {
+		for k := range t.Limits {
+			if !(t.Limits[k] >= 0) {
+				return false
+			}
+		}
+		return true
+	}()) && (func() int {
+		if func() bool { _, ok := t.Limits["min_users"]; return ok }() {
+			return t.Limits["min_users"]
+		}
+		return 0
+	}() <= t.Limits["max_users"]))) {
+		err := ErrCELExtensionsLimitsCELValidation
+		err.Value = t.Limits
+		errs = append(errs, err)
+	}
+
+	if !(((func(a, b []string) bool {
+		for _, v := range b {
+			if !slices.Contains(a, v) {
+				return false
+			}
+		}
+		return true
+	}([]string{"admin", "user", "guest"}, t.Roles)) && (!(func(a, b []string) bool {
+		for _, v := range b {
+			if slices.Contains(a, v) {
+				return true
+			}
+		}
+		return false
+	}(t.Roles, []string{"root"})))) && ((func(a, b []string) bool {
+		for _, v := range a {
+			if !slices.Contains(b, v) {
+				return false
+			}
+		}
+		for _, v := range b {
+			if !slices.Contains(a, v) {
+				return false
+			}
+		}
+		return true
+	}(t.Roles, func(l []string) []string { r := slices.Clone(l); slices.Reverse(r); return r }(t.Roles))) && (func() string {
+		if len(t.Roles) > 0 {
+			return t.Roles[0]
+		}
+		return ""
+	}() != "guest"))) {
+		err := ErrCELExtensionsRolesCELValidation
+		err.Value = t.Roles
+		errs = append(errs, err)
+	}
+
+	if !((((int64(t.Start.Year()) >= 2000) && ((int64(t.Start.Month()) - 1) < 11)) && ((int64(t.Start.Day())-1)+1 == int64(t.Start.Day()))) && ((int64(t.Start.Weekday()) != 0) && (strings.HasPrefix(t.Start.Format(time.RFC3339Nano), "20")))) {
+		err := ErrCELExtensionsStartCELValidation
+		err.Value = t.Start
+		errs = append(errs, err)
+	}
+
+	if !(((int64(t.Timeout.Seconds()) <= 3600) && (int64(t.Timeout.Minutes())*60 <= int64(t.Timeout.Seconds()))) && (t.Timeout.Milliseconds()%1000 == 0)) {
+		err := ErrCELExtensionsTimeoutCELValidation
+		err.Value = t.Timeout
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*CELExtensions)(nil)
+
+func (t *CELExtensions) Validate() error {
+	return ValidateCELExtensions(t)
+}
@@ -0,0 +1,52 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilCELFunctions is returned when the CELFunctions is nil.
+	ErrNilCELFunctions = errors.New("input CELFunctions is nil")
+
+	// ErrCELFunctionsVATNumberCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELFunctionsVATNumberCELValidation = govaliderrors.ValidationError{Reason: "field VATNumber failed CEL validation: isValidVAT(value) && !value.startsWith('XX')", Path: "CELFunctions.VATNumber", Type: "cel", Param: "isValidVAT(value) && !value.startsWith('XX')"}
+
+	// ErrCELFunctionsUsedCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELFunctionsUsedCELValidation = govaliderrors.ValidationError{Reason: "field Used failed CEL validation: withinQuota(value, this.Quota)", Path: "CELFunctions.Used", Type: "cel", Param: "withinQuota(value, this.Quota)"}
+)
+
+func ValidateCELFunctions(t *CELFunctions) error {
+	if t == nil {
+		return ErrNilCELFunctions
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !((IsValidVAT(t.VATNumber)) && (!(strings.HasPrefix(t.VATNumber, "XX")))) {
+		err := ErrCELFunctionsVATNumberCELValidation
+		err.Value = t.VATNumber
+		errs = append(errs, err)
+	}
+
+	if !(withinQuota(t.Used, t.Quota)) {
+		err := ErrCELFunctionsUsedCELValidation
+		err.Value = t.Used
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*CELFunctions)(nil)
+
+func (t *CELFunctions) Validate() error {
+	return ValidateCELFunctions(t)
+}
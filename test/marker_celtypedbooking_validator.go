@@ -0,0 +1,61 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilCELTypedBooking is returned when the CELTypedBooking is nil.
+	ErrNilCELTypedBooking = errors.New("input CELTypedBooking is nil")
+
+	// ErrCELTypedBookingFromCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELTypedBookingFromCELValidation = govaliderrors.ValidationError{Reason: "field From failed CEL validation: value < this.until && this.until - value <= this.max_stay", Path: "CELTypedBooking.From", Type: "cel", Param: "value < this.until && this.until - value <= this.max_stay"}
+
+	// ErrCELTypedBookingPlanCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELTypedBookingPlanCELValidation = govaliderrors.ValidationError{Reason: "field Plan failed CEL validation: value.startsWith('plan-') && value != this.Previous", Path: "CELTypedBooking.Plan", Type: "cel", Param: "value.startsWith('plan-') && value != this.Previous"}
+
+	// ErrCELTypedBookingGuestsCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrCELTypedBookingGuestsCELValidation = govaliderrors.ValidationError{Reason: "field Guests failed CEL validation: value <= this.Rooms", Path: "CELTypedBooking.Guests", Type: "cel", Param: "value <= this.Rooms"}
+)
+
+func ValidateCELTypedBooking(t *CELTypedBooking) error {
+	if t == nil {
+		return ErrNilCELTypedBooking
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !((t.From.Before(t.Until)) && (t.Until.Sub(t.From) <= t.MaxStay)) {
+		err := ErrCELTypedBookingFromCELValidation
+		err.Value = t.From
+		errs = append(errs, err)
+	}
+
+	if !((strings.HasPrefix(string(t.Plan), "plan-")) && (string(t.Plan) != t.Previous)) {
+		err := ErrCELTypedBookingPlanCELValidation
+		err.Value = t.Plan
+		errs = append(errs, err)
+	}
+
+	if !(int64(t.Guests) <= int64(t.Rooms)) {
+		err := ErrCELTypedBookingGuestsCELValidation
+		err.Value = t.Guests
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*CELTypedBooking)(nil)
+
+func (t *CELTypedBooking) Validate() error {
+	return ValidateCELTypedBooking(t)
+}
@@ -0,0 +1,67 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilCheckout is returned when the Checkout is nil.
+	ErrNilCheckout = errors.New("input Checkout is nil")
+
+	// ErrCheckoutExactlyOneOfValidation is the error returned when the fields CardToken, BankAccount, Wallet of Checkout break the exactly_one_of constraint.
+	ErrCheckoutExactlyOneOfValidation = govaliderrors.ValidationError{Reason: "exactly one of CardToken, BankAccount, Wallet must be set", Path: "Checkout", Type: "exactly_one_of", Param: "CardToken BankAccount Wallet"}
+
+	// ErrCheckoutAtMostOneOfValidation is the error returned when the fields Coupon, GiftCard of Checkout break the at_most_one_of constraint.
+	ErrCheckoutAtMostOneOfValidation = govaliderrors.ValidationError{Reason: "at most one of Coupon, GiftCard may be set", Path: "Checkout", Type: "at_most_one_of", Param: "Coupon GiftCard"}
+
+	// ErrCheckoutAllOrNoneOfValidation is the error returned when the fields Street, City of Checkout break the all_or_none_of constraint.
+	ErrCheckoutAllOrNoneOfValidation = govaliderrors.ValidationError{Reason: "either all or none of Street, City must be set", Path: "Checkout", Type: "all_or_none_of", Param: "Street City"}
+
+	// ErrCheckoutOneOfRequiredValidation is the error returned when the fields Email, Phone of Checkout break the one_of_required constraint.
+	ErrCheckoutOneOfRequiredValidation = govaliderrors.ValidationError{Reason: "at least one of Email, Phone is required", Path: "Checkout", Type: "one_of_required", Param: "Email Phone"}
+)
+
+func ValidateCheckout(t *Checkout) error {
+	if t == nil {
+		return ErrNilCheckout
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if validationhelper.CountTrue(t.CardToken != "", t.BankAccount != nil, !reflect.ValueOf(t.Wallet).IsZero()) != 1 {
+		err := ErrCheckoutExactlyOneOfValidation
+		errs = append(errs, err)
+	}
+
+	if validationhelper.CountTrue(t.Coupon != "", t.GiftCard != "") > 1 {
+		err := ErrCheckoutAtMostOneOfValidation
+		errs = append(errs, err)
+	}
+
+	if (t.Street != "" || t.City != "") && !(t.Street != "" && t.City != "") {
+		err := ErrCheckoutAllOrNoneOfValidation
+		errs = append(errs, err)
+	}
+
+	if !(t.Email != "" || t.Phone != "") {
+		err := ErrCheckoutOneOfRequiredValidation
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*Checkout)(nil)
+
+func (t *Checkout) Validate() error {
+	return ValidateCheckout(t)
+}
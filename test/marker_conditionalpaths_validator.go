@@ -0,0 +1,89 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilConditionalPaths is returned when the ConditionalPaths is nil.
+	ErrNilConditionalPaths = errors.New("input ConditionalPaths is nil")
+
+	// Deprecated: Use ErrConditionalPathsAddressZipRequiredIfValidation
+	//
+	// ErrConditionalPathsZipRequiredIfValidation is deprecated and is kept for compatibility purpose.
+	ErrConditionalPathsZipRequiredIfValidation = ErrConditionalPathsAddressZipRequiredIfValidation
+
+	// ErrConditionalPathsAddressZipRequiredIfValidation is the error returned when the field is required due to another field's value.
+	ErrConditionalPathsAddressZipRequiredIfValidation = govaliderrors.ValidationError{Reason: "field Zip is required when ..Country equals \"US\"", Path: "ConditionalPaths.Address.Zip", Type: "required_if", Param: "..Country \"US\""}
+
+	// Deprecated: Use ErrConditionalPathsItemsiLabelRequiredWithValidation
+	//
+	// ErrConditionalPathsLabelRequiredWithValidation is deprecated and is kept for compatibility purpose.
+	ErrConditionalPathsLabelRequiredWithValidation = ErrConditionalPathsItemsiLabelRequiredWithValidation
+
+	// ErrConditionalPathsItemsiLabelRequiredWithValidation is the error returned when the field is required because other fields are present.
+	ErrConditionalPathsItemsiLabelRequiredWithValidation = govaliderrors.ValidationError{Reason: "field Label is required when any of $root.Address.Street are present", Path: "ConditionalPaths.Items[i].Label", Type: "required_with", Param: "$root.Address.Street"}
+
+	// Deprecated: Use ErrConditionalPathsItemsiInsuranceExcludedIfValidation
+	//
+	// ErrConditionalPathsInsuranceExcludedIfValidation is deprecated and is kept for compatibility purpose.
+	ErrConditionalPathsInsuranceExcludedIfValidation = ErrConditionalPathsItemsiInsuranceExcludedIfValidation
+
+	// ErrConditionalPathsItemsiInsuranceExcludedIfValidation is the error returned when the field must be absent due to another field's value.
+	ErrConditionalPathsItemsiInsuranceExcludedIfValidation = govaliderrors.ValidationError{Reason: "field Insurance must be absent when $root.Express equals false", Path: "ConditionalPaths.Items[i].Insurance", Type: "excluded_if", Param: "$root.Express false"}
+)
+
+func ValidateConditionalPaths(t *ConditionalPaths) error {
+	if t == nil {
+		return ErrNilConditionalPaths
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	root := t
+
+	{
+		t := t.Address
+
+		if root.Country == "US" && t.Zip == "" {
+			err := ErrConditionalPathsAddressZipRequiredIfValidation
+			err.Value = t.Zip
+			errs = append(errs, err)
+		}
+
+	}
+
+	for i := range t.Items {
+		{
+			t := t.Items[i]
+
+			if (root.Address.Street != "") && t.Label == "" {
+				err := ErrConditionalPathsItemsiLabelRequiredWithValidation.AtIndex(i)
+				err.Value = t.Label
+				errs = append(errs, err)
+			}
+
+			if root.Express == false && t.Insurance != "" {
+				err := ErrConditionalPathsItemsiInsuranceExcludedIfValidation.AtIndex(i)
+				err.Value = t.Insurance
+				errs = append(errs, err)
+			}
+
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*ConditionalPaths)(nil)
+
+func (t *ConditionalPaths) Validate() error {
+	return ValidateConditionalPaths(t)
+}
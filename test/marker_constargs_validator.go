@@ -0,0 +1,62 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilConstArgs is returned when the ConstArgs is nil.
+	ErrNilConstArgs = errors.New("input ConstArgs is nil")
+
+	// ErrConstArgsNameMaxLengthValidation is the error returned when the length of the field exceeds the maximum of 5.
+	ErrConstArgsNameMaxLengthValidation = govaliderrors.ValidationError{Reason: "field Name must have a maximum length of 5", Path: "ConstArgs.Name", Type: "maxlength", Param: "5"}
+
+	// ErrConstArgsRetriesLTEValidation is the error returned when the value of the field is greater than 3.
+	ErrConstArgsRetriesLTEValidation = govaliderrors.ValidationError{Reason: "field Retries must be less than or equal to 3", Path: "ConstArgs.Retries", Type: "lte", Param: "3"}
+
+	// ErrConstArgsLayoutOneofValidation is the error returned when the field is not one of the allowed values.
+	ErrConstArgsLayoutOneofValidation = govaliderrors.ValidationError{Reason: "field Layout must be one of 2006-01-02T15:04:05Z07:00 3:04PM iso", Path: "ConstArgs.Layout", Type: "oneof", Param: "2006-01-02T15:04:05Z07:00 3:04PM iso"}
+)
+
+func ValidateConstArgs(t *ConstArgs) error {
+	if t == nil {
+		return ErrNilConstArgs
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if utf8.RuneCountInString(t.Name) > ConstArgsMaxNameLen {
+		err := ErrConstArgsNameMaxLengthValidation
+		err.Value = t.Name
+		errs = append(errs, err)
+	}
+
+	if !(t.Retries <= ConstArgsMaxRetries) {
+		err := ErrConstArgsRetriesLTEValidation
+		err.Value = t.Retries
+		errs = append(errs, err)
+	}
+
+	if !(t.Layout == time.RFC3339 || t.Layout == time.Kitchen || t.Layout == "iso") {
+		err := ErrConstArgsLayoutOneofValidation
+		err.Value = t.Layout
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*ConstArgs)(nil)
+
+func (t *ConstArgs) Validate() error {
+	return ValidateConstArgs(t)
+}
@@ -0,0 +1,59 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilDateRange is returned when the DateRange is nil.
+	ErrNilDateRange = errors.New("input DateRange is nil")
+
+	// ErrDateRangeStartRequiredValidation is returned when the Start is required but not provided.
+	ErrDateRangeStartRequiredValidation = govaliderrors.ValidationError{Reason: "field Start is required", Path: "DateRange.Start", Type: "required"}
+
+	// ErrDateRangeEndRequiredValidation is returned when the End is required but not provided.
+	ErrDateRangeEndRequiredValidation = govaliderrors.ValidationError{Reason: "field End is required", Path: "DateRange.End", Type: "required"}
+
+	// ErrDateRangeCELValidation is the error returned when the struct-level CEL expression evaluation fails.
+	ErrDateRangeCELValidation = govaliderrors.ValidationError{Reason: "DateRange failed CEL validation: self.Start < self.End", Path: "DateRange", Type: "cel", Param: "self.Start < self.End"}
+)
+
+func ValidateDateRange(t *DateRange) error {
+	if t == nil {
+		return ErrNilDateRange
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.Start == 0 {
+		err := ErrDateRangeStartRequiredValidation
+		err.Value = t.Start
+		errs = append(errs, err)
+	}
+
+	if t.End == 0 {
+		err := ErrDateRangeEndRequiredValidation
+		err.Value = t.End
+		errs = append(errs, err)
+	}
+
+	if !(t.Start < t.End) {
+		err := ErrDateRangeCELValidation
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*DateRange)(nil)
+
+func (t *DateRange) Validate() error {
+	return ValidateDateRange(t)
+}
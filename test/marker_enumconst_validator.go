@@ -0,0 +1,64 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"time"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilEnumConst is returned when the EnumConst is nil.
+	ErrNilEnumConst = errors.New("input EnumConst is nil")
+
+	// ErrEnumConstStatusEnumValidation is the error returned when the value is not in the allowed enum values active, inactive.
+	ErrEnumConstStatusEnumValidation = govaliderrors.ValidationError{Reason: "field Status must be one of active, inactive", Path: "EnumConst.Status", Type: "enum", Param: "active, inactive"}
+
+	// ErrEnumConstWeekdayEnumValidation is the error returned when the value is not in the allowed enum values 0, 1, 2, 3, 4, 5, 6.
+	ErrEnumConstWeekdayEnumValidation = govaliderrors.ValidationError{Reason: "field Weekday must be one of 0, 1, 2, 3, 4, 5, 6", Path: "EnumConst.Weekday", Type: "enum", Param: "0, 1, 2, 3, 4, 5, 6"}
+)
+
+func ValidateEnumConst(t *EnumConst) error {
+	if t == nil {
+		return ErrNilEnumConst
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if func() bool {
+		switch t.Status {
+		case EnumStatusActive, EnumStatusInactive:
+			return false
+		}
+		return true
+	}() {
+		err := ErrEnumConstStatusEnumValidation
+		err.Value = t.Status
+		errs = append(errs, err)
+	}
+
+	if func() bool {
+		switch t.Weekday {
+		case time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday:
+			return false
+		}
+		return true
+	}() {
+		err := ErrEnumConstWeekdayEnumValidation
+		err.Value = t.Weekday
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*EnumConst)(nil)
+
+func (t *EnumConst) Validate() error {
+	return ValidateEnumConst(t)
+}
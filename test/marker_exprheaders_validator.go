@@ -0,0 +1,51 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilExprHeaders is returned when the ExprHeaders is nil.
+	ErrNilExprHeaders = errors.New("input ExprHeaders is nil")
+
+	// ErrExprHeadersNameExprValidation is the error returned when the Go expression of the field does not hold.
+	ErrExprHeadersNameExprValidation = govaliderrors.ValidationError{Reason: "field Name failed expression validation: len(self.Tags) <= self.MaxTags && strings.HasPrefix(value, \"x-\")", Path: "ExprHeaders.Name", Type: "expr", Param: "len(self.Tags) <= self.MaxTags && strings.HasPrefix(value, \"x-\")"}
+
+	// ErrExprHeadersExprValidation is the error returned when the struct-level Go expression does not hold.
+	ErrExprHeadersExprValidation = govaliderrors.ValidationError{Reason: "ExprHeaders failed expression validation: self.Until.IsZero() || self.From.Before(self.Until)", Path: "ExprHeaders", Type: "expr", Param: "self.Until.IsZero() || self.From.Before(self.Until)"}
+)
+
+func ValidateExprHeaders(t *ExprHeaders) error {
+	if t == nil {
+		return ErrNilExprHeaders
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !(len(t.Tags) <= t.MaxTags && strings.HasPrefix(t.Name, "x-")) {
+		err := ErrExprHeadersNameExprValidation
+		err.Value = t.Name
+		errs = append(errs, err)
+	}
+
+	if !(t.Until.IsZero() || t.From.Before(t.Until)) {
+		err := ErrExprHeadersExprValidation
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*ExprHeaders)(nil)
+
+func (t *ExprHeaders) Validate() error {
+	return ValidateExprHeaders(t)
+}
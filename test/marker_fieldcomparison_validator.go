@@ -0,0 +1,132 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilFieldComparison is returned when the FieldComparison is nil.
+	ErrNilFieldComparison = errors.New("input FieldComparison is nil")
+
+	// ErrFieldComparisonConfirmPasswordEqFieldValidation is the error returned when the field ConfirmPassword must be equal to Password.
+	ErrFieldComparisonConfirmPasswordEqFieldValidation = govaliderrors.ValidationError{Reason: "field ConfirmPassword must be equal to Password", Path: "FieldComparison.ConfirmPassword", Type: "eqfield", Param: "Password"}
+
+	// ErrFieldComparisonNewPinNeFieldValidation is the error returned when the field NewPin must not be equal to CurrentPin.
+	ErrFieldComparisonNewPinNeFieldValidation = govaliderrors.ValidationError{Reason: "field NewPin must not be equal to CurrentPin", Path: "FieldComparison.NewPin", Type: "nefield", Param: "CurrentPin"}
+
+	// ErrFieldComparisonClosesGtFieldValidation is the error returned when the field Closes must be greater than Opens.
+	ErrFieldComparisonClosesGtFieldValidation = govaliderrors.ValidationError{Reason: "field Closes must be greater than Opens", Path: "FieldComparison.Closes", Type: "gtfield", Param: "Opens"}
+
+	// ErrFieldComparisonMaxAgeGteFieldValidation is the error returned when the field MaxAge must be greater than or equal to MinAge.
+	ErrFieldComparisonMaxAgeGteFieldValidation = govaliderrors.ValidationError{Reason: "field MaxAge must be greater than or equal to MinAge", Path: "FieldComparison.MaxAge", Type: "gtefield", Param: "MinAge"}
+
+	// ErrFieldComparisonRetryLtFieldValidation is the error returned when the field Retry must be less than Timeout.
+	ErrFieldComparisonRetryLtFieldValidation = govaliderrors.ValidationError{Reason: "field Retry must be less than Timeout", Path: "FieldComparison.Retry", Type: "ltfield", Param: "Timeout"}
+
+	// ErrFieldComparisonBookedLteFieldValidation is the error returned when the field Booked must be less than or equal to Capacity.
+	ErrFieldComparisonBookedLteFieldValidation = govaliderrors.ValidationError{Reason: "field Booked must be less than or equal to Capacity", Path: "FieldComparison.Booked", Type: "ltefield", Param: "Capacity"}
+
+	// ErrFieldComparisonConfirmEmailEqFieldValidation is the error returned when the field ConfirmEmail must be equal to Email.
+	ErrFieldComparisonConfirmEmailEqFieldValidation = govaliderrors.ValidationError{Reason: "field ConfirmEmail must be equal to Email", Path: "FieldComparison.ConfirmEmail", Type: "eqfield", Param: "Email"}
+
+	// ErrFieldComparisonSlotsiAtGteCSFieldValidation is the error returned when the field At must be greater than or equal to Opens.
+	ErrFieldComparisonSlotsiAtGteCSFieldValidation = govaliderrors.ValidationError{Reason: "field At must be greater than or equal to Opens", Path: "FieldComparison.Slots[i].At", Type: "gtecsfield", Param: "Opens"}
+
+	// ErrFieldComparisonSlotsiAtLteCSFieldValidation is the error returned when the field At must be less than or equal to Closes.
+	ErrFieldComparisonSlotsiAtLteCSFieldValidation = govaliderrors.ValidationError{Reason: "field At must be less than or equal to Closes", Path: "FieldComparison.Slots[i].At", Type: "ltecsfield", Param: "Closes"}
+
+	// ErrFieldComparisonSlotsiCodeNeCSFieldValidation is the error returned when the field Code must not be equal to Password.
+	ErrFieldComparisonSlotsiCodeNeCSFieldValidation = govaliderrors.ValidationError{Reason: "field Code must not be equal to Password", Path: "FieldComparison.Slots[i].Code", Type: "necsfield", Param: "Password"}
+)
+
+func ValidateFieldComparison(t *FieldComparison) error {
+	if t == nil {
+		return ErrNilFieldComparison
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	root := t
+
+	if t.ConfirmPassword != t.Password {
+		err := ErrFieldComparisonConfirmPasswordEqFieldValidation
+		err.Value = t.ConfirmPassword
+		errs = append(errs, err)
+	}
+
+	if t.NewPin == t.CurrentPin {
+		err := ErrFieldComparisonNewPinNeFieldValidation
+		err.Value = t.NewPin
+		errs = append(errs, err)
+	}
+
+	if !t.Closes.After(t.Opens) {
+		err := ErrFieldComparisonClosesGtFieldValidation
+		err.Value = t.Closes
+		errs = append(errs, err)
+	}
+
+	if t.MaxAge < t.MinAge {
+		err := ErrFieldComparisonMaxAgeGteFieldValidation
+		err.Value = t.MaxAge
+		errs = append(errs, err)
+	}
+
+	if t.Retry >= t.Timeout {
+		err := ErrFieldComparisonRetryLtFieldValidation
+		err.Value = t.Retry
+		errs = append(errs, err)
+	}
+
+	if t.Booked > t.Capacity {
+		err := ErrFieldComparisonBookedLteFieldValidation
+		err.Value = t.Booked
+		errs = append(errs, err)
+	}
+
+	if t.ConfirmEmail != nil && t.Email != nil && *t.ConfirmEmail != *t.Email {
+		err := ErrFieldComparisonConfirmEmailEqFieldValidation
+		err.Value = t.ConfirmEmail
+		errs = append(errs, err)
+	}
+
+	for i := range t.Slots {
+		{
+			t := t.Slots[i]
+
+			if t.At.Before(root.Opens) {
+				err := ErrFieldComparisonSlotsiAtGteCSFieldValidation.AtIndex(i)
+				err.Value = t.At
+				errs = append(errs, err)
+			}
+
+			if t.At.After(root.Closes) {
+				err := ErrFieldComparisonSlotsiAtLteCSFieldValidation.AtIndex(i)
+				err.Value = t.At
+				errs = append(errs, err)
+			}
+
+			if t.Code == root.Password {
+				err := ErrFieldComparisonSlotsiCodeNeCSFieldValidation.AtIndex(i)
+				err.Value = t.Code
+				errs = append(errs, err)
+			}
+
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*FieldComparison)(nil)
+
+func (t *FieldComparison) Validate() error {
+	return ValidateFieldComparison(t)
+}
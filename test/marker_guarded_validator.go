@@ -0,0 +1,85 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilGuarded is returned when the Guarded is nil.
+	ErrNilGuarded = errors.New("input Guarded is nil")
+
+	// ErrGuardedZipLengthValidation is the error returned when the length of the field is not exactly 5.
+	ErrGuardedZipLengthValidation = govaliderrors.ValidationError{Reason: "field Zip length must be exactly 5", Path: "Guarded.Zip", Type: "length", Param: "5"}
+
+	// ErrGuardedZipNumericValidation is the error returned when the field Zip is not numeric.
+	ErrGuardedZipNumericValidation = govaliderrors.ValidationError{Reason: "field Zip must be numeric", Path: "Guarded.Zip", Type: "numeric"}
+
+	// ErrGuardedCompanyRequiredValidation is returned when the Company is required but not provided.
+	ErrGuardedCompanyRequiredValidation = govaliderrors.ValidationError{Reason: "field Company is required", Path: "Guarded.Company", Type: "required"}
+
+	// Deprecated: Use ErrGuardedRefereesiEmailEmailValidation
+	//
+	// ErrGuardedEmailEmailValidation is deprecated and is kept for compatibility purpose.
+	ErrGuardedEmailEmailValidation = ErrGuardedRefereesiEmailEmailValidation
+
+	// ErrGuardedRefereesiEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrGuardedRefereesiEmailEmailValidation = govaliderrors.ValidationError{Reason: "field Email must be a valid email address", Path: "Guarded.Referees[i].Email", Type: "email"}
+)
+
+func ValidateGuarded(t *Guarded) error {
+	if t == nil {
+		return ErrNilGuarded
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	root := t
+
+	if t.Country == "US" && (utf8.RuneCountInString(t.Zip) != 5) {
+		err := ErrGuardedZipLengthValidation
+		err.Value = t.Zip
+		errs = append(errs, err)
+	}
+
+	if t.Country == "US" && (!validationhelper.IsNumeric(t.Zip)) {
+		err := ErrGuardedZipNumericValidation
+		err.Value = t.Zip
+		errs = append(errs, err)
+	}
+
+	if ((t.Type == "business") && (t.Age >= 18)) && (t.Company == "") {
+		err := ErrGuardedCompanyRequiredValidation
+		err.Value = t.Company
+		errs = append(errs, err)
+	}
+
+	for i := range t.Referees {
+		{
+			t := t.Referees[i]
+
+			if root.Type == "business" && (!validationhelper.IsValidEmail(t.Email)) {
+				err := ErrGuardedRefereesiEmailEmailValidation.AtIndex(i)
+				err.Value = t.Email
+				errs = append(errs, err)
+			}
+
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*Guarded)(nil)
+
+func (t *Guarded) Validate() error {
+	return ValidateGuarded(t)
+}
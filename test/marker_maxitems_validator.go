@@ -13,13 +13,13 @@ var (
 	ErrNilMaxItems = errors.New("input MaxItems is nil")
 
 	// ErrMaxItemsItemsMaxItemsValidation is the error returned when the length of the field exceeds the maximum of 5.
-	ErrMaxItemsItemsMaxItemsValidation = govaliderrors.ValidationError{Reason: "field Items must have a maximum of 5 items", Path: "MaxItems.Items", Type: "maxitems"}
+	ErrMaxItemsItemsMaxItemsValidation = govaliderrors.ValidationError{Reason: "field Items must have a maximum of 5 items", Path: "MaxItems.Items", Type: "maxitems", Param: "5"}
 
 	// ErrMaxItemsMetadataMaxItemsValidation is the error returned when the length of the field exceeds the maximum of 3.
-	ErrMaxItemsMetadataMaxItemsValidation = govaliderrors.ValidationError{Reason: "field Metadata must have a maximum of 3 items", Path: "MaxItems.Metadata", Type: "maxitems"}
+	ErrMaxItemsMetadataMaxItemsValidation = govaliderrors.ValidationError{Reason: "field Metadata must have a maximum of 3 items", Path: "MaxItems.Metadata", Type: "maxitems", Param: "3"}
 
 	// ErrMaxItemsChanFieldMaxItemsValidation is the error returned when the length of the field exceeds the maximum of 2.
-	ErrMaxItemsChanFieldMaxItemsValidation = govaliderrors.ValidationError{Reason: "field ChanField must have a maximum of 2 items", Path: "MaxItems.ChanField", Type: "maxitems"}
+	ErrMaxItemsChanFieldMaxItemsValidation = govaliderrors.ValidationError{Reason: "field ChanField must have a maximum of 2 items", Path: "MaxItems.ChanField", Type: "maxitems", Param: "2"}
 )
 
 func ValidateMaxItems(t *MaxItems) error {
@@ -0,0 +1,92 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilMembership is returned when the Membership is nil.
+	ErrNilMembership = errors.New("input Membership is nil")
+
+	// ErrMembershipIDRequiredValidation is returned when the ID is required but not provided.
+	ErrMembershipIDRequiredValidation = govaliderrors.ValidationError{Reason: "field ID is required", Path: "Membership.ID", Type: "required"}
+
+	// ErrMembershipEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrMembershipEmailEmailValidation = govaliderrors.ValidationError{Reason: "field Email must be a valid email address", Path: "Membership.Email", Type: "email"}
+
+	// ErrMembershipPasswordMinLengthValidation is the error returned when the length of the field is less than the minimum of 8.
+	ErrMembershipPasswordMinLengthValidation = govaliderrors.ValidationError{Reason: "field Password must have a minimum length of 8", Path: "Membership.Password", Type: "minlength", Param: "8"}
+
+	// ErrMembershipPasswordRequiredValidation is returned when the Password is required but not provided.
+	ErrMembershipPasswordRequiredValidation = govaliderrors.ValidationError{Reason: "field Password is required", Path: "Membership.Password", Type: "required"}
+)
+
+func ValidateMembership(t *Membership) error {
+	if t == nil {
+		return ErrNilMembership
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !validationhelper.IsValidEmail(t.Email) {
+		err := ErrMembershipEmailEmailValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*Membership)(nil)
+
+func (t *Membership) Validate() error {
+	return ValidateMembership(t)
+}
+
+// ValidateMembershipGroups validates t like ValidateMembership, also running the rules of the given
+// validation groups, such as "create" or "update". Rules without groups always run.
+func ValidateMembershipGroups(t *Membership, groups ...string) error {
+	if t == nil {
+		return ErrNilMembership
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if validationhelper.InGroups(groups, "update") && (t.ID == "") {
+		err := ErrMembershipIDRequiredValidation
+		err.Value = t.ID
+		errs = append(errs, err)
+	}
+
+	if !validationhelper.IsValidEmail(t.Email) {
+		err := ErrMembershipEmailEmailValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
+	if validationhelper.InGroups(groups, "create", "update") && (utf8.RuneCountInString(t.Password) < 8) {
+		err := ErrMembershipPasswordMinLengthValidation
+		err.Value = t.Password
+		errs = append(errs, err)
+	}
+
+	if validationhelper.InGroups(groups, "create") && (t.Password == "") {
+		err := ErrMembershipPasswordRequiredValidation
+		err.Value = t.Password
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -14,7 +14,7 @@ var (
 	ErrNilMinLength = errors.New("input MinLength is nil")
 
 	// ErrMinLengthNameMinLengthValidation is the error returned when the length of the field is less than the minimum of 3.
-	ErrMinLengthNameMinLengthValidation = govaliderrors.ValidationError{Reason: "field Name must have a minimum length of 3", Path: "MinLength.Name", Type: "minlength"}
+	ErrMinLengthNameMinLengthValidation = govaliderrors.ValidationError{Reason: "field Name must have a minimum length of 3", Path: "MinLength.Name", Type: "minlength", Param: "3"}
 )
 
 func ValidateMinLength(t *MinLength) error {
@@ -17,7 +17,7 @@ var (
 	ErrMultipleErrorsURLRequiredValidation = govaliderrors.ValidationError{Reason: "field URL is required", Path: "MultipleErrors.URL", Type: "required"}
 
 	// ErrMultipleErrorsTooLongMaxLengthValidation is the error returned when the length of the field exceeds the maximum of 1.
-	ErrMultipleErrorsTooLongMaxLengthValidation = govaliderrors.ValidationError{Reason: "field TooLong must have a maximum length of 1", Path: "MultipleErrors.TooLong", Type: "maxlength"}
+	ErrMultipleErrorsTooLongMaxLengthValidation = govaliderrors.ValidationError{Reason: "field TooLong must have a maximum length of 1", Path: "MultipleErrors.TooLong", Type: "maxlength", Param: "1"}
 )
 
 func ValidateMultipleErrors(t *MultipleErrors) error {
@@ -0,0 +1,39 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+
+	// ErrNamedEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrNamedEmailEmailValidation = govaliderrors.ValidationError{Reason: "field NamedEmail must be a valid email address", Path: "NamedEmail", Type: "email"}
+)
+
+// ValidateNamedEmail validates v with the rules declared on NamedEmail, which also apply
+// to the fields of that type.
+func ValidateNamedEmail(v NamedEmail) error {
+	t := struct{ NamedEmail NamedEmail }{v}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !validationhelper.IsValidEmail(string(t.NamedEmail)) {
+		err := ErrNamedEmailEmailValidation
+		err.Value = t.NamedEmail
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*NamedEmail)(nil)
+
+func (v NamedEmail) Validate() error {
+	return ValidateNamedEmail(v)
+}
@@ -0,0 +1,47 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+
+	// ErrNamedTagsMaxItemsValidation is the error returned when the length of the field exceeds the maximum of 3.
+	ErrNamedTagsMaxItemsValidation = govaliderrors.ValidationError{Reason: "field NamedTags must have a maximum of 3 items", Path: "NamedTags", Type: "maxitems", Param: "3"}
+
+	// ErrNamedTagsMinItemsValidation is the error returned when the length of the field is less than the minimum of 1.
+	ErrNamedTagsMinItemsValidation = govaliderrors.ValidationError{Reason: "field NamedTags must have a minimum of 1 items", Path: "NamedTags", Type: "minitems", Param: "1"}
+)
+
+// ValidateNamedTags validates v with the rules declared on NamedTags, which also apply
+// to the fields of that type.
+func ValidateNamedTags(v NamedTags) error {
+	t := struct{ NamedTags NamedTags }{v}
+
+	var errs govaliderrors.ValidationErrors
+
+	if len(t.NamedTags) > 3 {
+		err := ErrNamedTagsMaxItemsValidation
+		err.Value = t.NamedTags
+		errs = append(errs, err)
+	}
+
+	if len(t.NamedTags) < 1 {
+		err := ErrNamedTagsMinItemsValidation
+		err.Value = t.NamedTags
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*NamedTags)(nil)
+
+func (v NamedTags) Validate() error {
+	return ValidateNamedTags(v)
+}
@@ -0,0 +1,70 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilNamedTypes is returned when the NamedTypes is nil.
+	ErrNilNamedTypes = errors.New("input NamedTypes is nil")
+
+	// ErrNamedTypesEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrNamedTypesEmailEmailValidation = govaliderrors.ValidationError{Reason: "field Email must be a valid email address", Path: "NamedTypes.Email", Type: "email"}
+
+	// ErrNamedTypesTagsMinItemsValidation is the error returned when the length of the field is less than the minimum of 1.
+	ErrNamedTypesTagsMinItemsValidation = govaliderrors.ValidationError{Reason: "field Tags must have a minimum of 1 items", Path: "NamedTypes.Tags", Type: "minitems", Param: "1"}
+
+	// ErrNamedTypesTagsMaxItemsValidation is the error returned when the length of the field exceeds the maximum of 2.
+	ErrNamedTypesTagsMaxItemsValidation = govaliderrors.ValidationError{Reason: "field Tags must have a maximum of 2 items", Path: "NamedTypes.Tags", Type: "maxitems", Param: "2"}
+
+	// ErrNamedTypesBackupEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrNamedTypesBackupEmailEmailValidation = govaliderrors.ValidationError{Reason: "field BackupEmail must be a valid email address", Path: "NamedTypes.BackupEmail", Type: "email"}
+)
+
+func ValidateNamedTypes(t *NamedTypes) error {
+	if t == nil {
+		return ErrNilNamedTypes
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !validationhelper.IsValidEmail(string(t.Email)) {
+		err := ErrNamedTypesEmailEmailValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
+	if len(t.Tags) < 1 {
+		err := ErrNamedTypesTagsMinItemsValidation
+		err.Value = t.Tags
+		errs = append(errs, err)
+	}
+
+	if len(t.Tags) > 2 {
+		err := ErrNamedTypesTagsMaxItemsValidation
+		err.Value = t.Tags
+		errs = append(errs, err)
+	}
+
+	if t.BackupEmail != nil && (!validationhelper.IsValidEmail(string(*t.BackupEmail))) {
+		err := ErrNamedTypesBackupEmailEmailValidation
+		err.Value = t.BackupEmail
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*NamedTypes)(nil)
+
+func (t *NamedTypes) Validate() error {
+	return ValidateNamedTypes(t)
+}
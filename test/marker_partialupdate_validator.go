@@ -0,0 +1,96 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/fieldmask"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilPartialUpdate is returned when the PartialUpdate is nil.
+	ErrNilPartialUpdate = errors.New("input PartialUpdate is nil")
+
+	// ErrPartialUpdateNameRequiredValidation is returned when the Name is required but not provided.
+	ErrPartialUpdateNameRequiredValidation = govaliderrors.ValidationError{Reason: "field Name is required", Path: "PartialUpdate.Name", Type: "required"}
+
+	// ErrPartialUpdateEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrPartialUpdateEmailEmailValidation = govaliderrors.ValidationError{Reason: "field Email must be a valid email address", Path: "PartialUpdate.Email", Type: "email"}
+
+	// ErrPartialUpdateActivatedByRequiredIfValidation is the error returned when the field is required due to another field's value.
+	ErrPartialUpdateActivatedByRequiredIfValidation = govaliderrors.ValidationError{Reason: "field ActivatedBy is required when Status equals \"active\"", Path: "PartialUpdate.ActivatedBy", Type: "required_if", Param: "Status \"active\""}
+)
+
+func ValidatePartialUpdate(t *PartialUpdate) error {
+	if t == nil {
+		return ErrNilPartialUpdate
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.Name == "" {
+		err := ErrPartialUpdateNameRequiredValidation
+		err.Value = t.Name
+		errs = append(errs, err)
+	}
+
+	if !validationhelper.IsValidEmail(t.Email) {
+		err := ErrPartialUpdateEmailEmailValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
+	if t.Status == "active" && t.ActivatedBy == "" {
+		err := ErrPartialUpdateActivatedByRequiredIfValidation
+		err.Value = t.ActivatedBy
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*PartialUpdate)(nil)
+
+func (t *PartialUpdate) Validate() error {
+	return ValidatePartialUpdate(t)
+}
+
+// ValidatePartialUpdateFields validates t like ValidatePartialUpdate, but only runs the rules of the fields
+// covered by mask and the conditional rules depending on them. Paths are dot-separated Go field names or
+// JSON keys, such as those returned by fieldmask.FromMergePatch for a partial update.
+func ValidatePartialUpdateFields(t *PartialUpdate, mask ...string) error {
+	if t == nil {
+		return ErrNilPartialUpdate
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if fieldmask.Covers(mask, "Name", "name") && (t.Name == "") {
+		err := ErrPartialUpdateNameRequiredValidation
+		err.Value = t.Name
+		errs = append(errs, err)
+	}
+
+	if fieldmask.Covers(mask, "Email", "email") && (!validationhelper.IsValidEmail(t.Email)) {
+		err := ErrPartialUpdateEmailEmailValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
+	if fieldmask.Covers(mask, "ActivatedBy", "activated_by", "Status", "status") && (t.Status == "active" && t.ActivatedBy == "") {
+		err := ErrPartialUpdateActivatedByRequiredIfValidation
+		err.Value = t.ActivatedBy
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilPresence is returned when the Presence is nil.
+	ErrNilPresence = errors.New("input Presence is nil")
+
+	// ErrPresenceQuantityRequiredValidation is returned when the Quantity is required but not provided.
+	ErrPresenceQuantityRequiredValidation = govaliderrors.ValidationError{Reason: "field Quantity is required", Path: "Presence.Quantity", Type: "required"}
+
+	// ErrPresenceDiscountRequiredValidation is returned when the Discount is required but not provided.
+	ErrPresenceDiscountRequiredValidation = govaliderrors.ValidationError{Reason: "field Discount is required", Path: "Presence.Discount", Type: "required"}
+
+	// ErrPresenceNoteNotNullValidation is the error returned when the field is null in the payload.
+	ErrPresenceNoteNotNullValidation = govaliderrors.ValidationError{Reason: "field Note must not be null", Path: "Presence.Note", Type: "not_null"}
+)
+
+func ValidatePresence(t *Presence) error {
+	if t == nil {
+		return ErrNilPresence
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if (t.Presence.Recorded() && (!t.Presence.Has("Quantity") || t.Presence.IsNull("Quantity"))) || (!t.Presence.Recorded() && t.Quantity == 0) {
+		err := ErrPresenceQuantityRequiredValidation
+		err.Value = t.Quantity
+		errs = append(errs, err)
+	}
+
+	if (t.Presence.Recorded() && (!t.Presence.Has("Discount"))) || (!t.Presence.Recorded() && t.Discount == nil) {
+		err := ErrPresenceDiscountRequiredValidation
+		err.Value = t.Discount
+		errs = append(errs, err)
+	}
+
+	if t.Presence.IsNull("Note") {
+		err := ErrPresenceNoteNotNullValidation
+		err.Value = t.Note
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*Presence)(nil)
+
+func (t *Presence) Validate() error {
+	return ValidatePresence(t)
+}
+
+// UnmarshalJSON decodes data into t and records the fields present in data in t.Presence,
+// so that validation can tell a missing field from one set to its zero value.
+func (t *Presence) UnmarshalJSON(data []byte) error {
+	type alias Presence
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	t.Presence.Reset()
+	for key, value := range raw {
+		null := string(value) == "null"
+		switch {
+		case strings.EqualFold(key, "quantity"):
+			t.Presence.Set("Quantity", null)
+		case strings.EqualFold(key, "discount"):
+			t.Presence.Set("Discount", null)
+		case strings.EqualFold(key, "note"):
+			t.Presence.Set("Note", null)
+		}
+	}
+
+	return nil
+}
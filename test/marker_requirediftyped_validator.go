@@ -0,0 +1,60 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilRequiredIfTyped is returned when the RequiredIfTyped is nil.
+	ErrNilRequiredIfTyped = errors.New("input RequiredIfTyped is nil")
+
+	// ErrRequiredIfTypedReasonRequiredIfValidation is the error returned when the field is required due to another field's value.
+	ErrRequiredIfTypedReasonRequiredIfValidation = govaliderrors.ValidationError{Reason: "field Reason is required when Count equals 3", Path: "RequiredIfTyped.Reason", Type: "required_if", Param: "Count 3"}
+
+	// ErrRequiredIfTypedOwnerRequiredIfValidation is the error returned when the field is required due to another field's value.
+	ErrRequiredIfTypedOwnerRequiredIfValidation = govaliderrors.ValidationError{Reason: "field Owner is required when Active equals true and Level equals RequiredIfLevelHigh", Path: "RequiredIfTyped.Owner", Type: "required_if", Param: "Active true Level RequiredIfLevelHigh"}
+
+	// ErrRequiredIfTypedOwnerRequiredUnlessValidation is the error returned when the field is required unless another field has a specific value.
+	ErrRequiredIfTypedOwnerRequiredUnlessValidation = govaliderrors.ValidationError{Reason: "field Owner is required unless Active equals false", Path: "RequiredIfTyped.Owner", Type: "required_unless", Param: "Active false"}
+)
+
+func ValidateRequiredIfTyped(t *RequiredIfTyped) error {
+	if t == nil {
+		return ErrNilRequiredIfTyped
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.Count == 3 && t.Reason == "" {
+		err := ErrRequiredIfTypedReasonRequiredIfValidation
+		err.Value = t.Reason
+		errs = append(errs, err)
+	}
+
+	if t.Active == true && t.Level == RequiredIfLevelHigh && t.Owner == "" {
+		err := ErrRequiredIfTypedOwnerRequiredIfValidation
+		err.Value = t.Owner
+		errs = append(errs, err)
+	}
+
+	if t.Active != false && t.Owner == "" {
+		err := ErrRequiredIfTypedOwnerRequiredUnlessValidation
+		err.Value = t.Owner
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*RequiredIfTyped)(nil)
+
+func (t *RequiredIfTyped) Validate() error {
+	return ValidateRequiredIfTyped(t)
+}
@@ -0,0 +1,138 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+	"reflect"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilVolume is returned when the Volume is nil.
+	ErrNilVolume = errors.New("input Volume is nil")
+
+	// ErrVolumeIDImmutableValidation is the error returned when the field is changed by an update.
+	ErrVolumeIDImmutableValidation = govaliderrors.ValidationError{Reason: "field ID is immutable", Path: "Volume.ID", Type: "immutable"}
+
+	// ErrVolumeIDRequiredValidation is returned when the ID is required but not provided.
+	ErrVolumeIDRequiredValidation = govaliderrors.ValidationError{Reason: "field ID is required", Path: "Volume.ID", Type: "required"}
+
+	// ErrVolumeNodeImmutableOnceSetValidation is the error returned when the field is changed by an update after being set.
+	ErrVolumeNodeImmutableOnceSetValidation = govaliderrors.ValidationError{Reason: "field Node cannot be changed once set", Path: "Volume.Node", Type: "immutable_once_set"}
+
+	// ErrVolumeGenerationCELValidation is the error returned when the CEL expression evaluation fails.
+	ErrVolumeGenerationCELValidation = govaliderrors.ValidationError{Reason: "field Generation failed CEL validation: value >= oldSelf", Path: "Volume.Generation", Type: "cel", Param: "value >= oldSelf"}
+
+	// ErrVolumeLabelsImmutableValidation is the error returned when the field is changed by an update.
+	ErrVolumeLabelsImmutableValidation = govaliderrors.ValidationError{Reason: "field Labels is immutable", Path: "Volume.Labels", Type: "immutable"}
+
+	// ErrVolumeAttachmentHostImmutableValidation is the error returned when the field is changed by an update.
+	ErrVolumeAttachmentHostImmutableValidation = govaliderrors.ValidationError{Reason: "field Host is immutable", Path: "Volume.Attachment.Host", Type: "immutable"}
+
+	// ErrVolumeAttachmentDeviceImmutableOnceSetValidation is the error returned when the field is changed by an update after being set.
+	ErrVolumeAttachmentDeviceImmutableOnceSetValidation = govaliderrors.ValidationError{Reason: "field Device cannot be changed once set", Path: "Volume.Attachment.Device", Type: "immutable_once_set"}
+)
+
+func ValidateVolume(t *Volume) error {
+	if t == nil {
+		return ErrNilVolume
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.ID == "" {
+		err := ErrVolumeIDRequiredValidation
+		err.Value = t.ID
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*Volume)(nil)
+
+func (t *Volume) Validate() error {
+	return ValidateVolume(t)
+}
+
+// ValidateVolumeUpdate validates t as an update of old: it runs the rules of ValidateVolume
+// and the transition rules comparing the fields of t with those of old, such as immutable. A nil old
+// validates t as a new value, without the transition rules.
+func ValidateVolumeUpdate(old, t *Volume) error {
+	if old == nil {
+		return ValidateVolume(t)
+	}
+
+	var errs govaliderrors.ValidationErrors
+	if err := ValidateVolume(t); err != nil {
+		if !errors.As(err, &errs) {
+			return err
+		}
+	}
+
+	if t.ID != old.ID {
+		err := ErrVolumeIDImmutableValidation
+		err.Value = t.ID
+		errs = append(errs, err)
+	}
+
+	if old.Node != "" && t.Node != old.Node {
+		err := ErrVolumeNodeImmutableOnceSetValidation
+		err.Value = t.Node
+		errs = append(errs, err)
+	}
+
+	if !(t.Generation >= old.Generation) {
+		err := ErrVolumeGenerationCELValidation
+		err.Value = t.Generation
+		errs = append(errs, err)
+	}
+
+	if !reflect.DeepEqual(t.Labels, old.Labels) {
+		err := ErrVolumeLabelsImmutableValidation
+		err.Value = t.Labels
+		errs = append(errs, err)
+	}
+
+	if t.Attachment != nil && old.Attachment != nil {
+		t := t.Attachment
+		old := old.Attachment
+
+		if t.Host != old.Host {
+			err := ErrVolumeAttachmentHostImmutableValidation
+			err.Value = t.Host
+			errs = append(errs, err)
+		}
+
+	} else {
+		if (t.Attachment != nil) != (old.Attachment != nil) {
+			errs = append(errs, ErrVolumeAttachmentHostImmutableValidation)
+		}
+	}
+
+	if t.Attachment != nil && old.Attachment != nil {
+		t := t.Attachment
+		old := old.Attachment
+
+		if old.Device != "" && t.Device != old.Device {
+			err := ErrVolumeAttachmentDeviceImmutableOnceSetValidation
+			err.Value = t.Device
+			errs = append(errs, err)
+		}
+
+	} else {
+		if old.Attachment != nil && old.Attachment.Device != "" {
+			errs = append(errs, ErrVolumeAttachmentDeviceImmutableOnceSetValidation)
+		}
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Code generated by govalid; DO NOT EDIT.
+package test
+
+import (
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilVolumeAttachment is returned when the VolumeAttachment is nil.
+	ErrNilVolumeAttachment = errors.New("input VolumeAttachment is nil")
+)
+
+func ValidateVolumeAttachment(t *VolumeAttachment) error {
+	if t == nil {
+		return ErrNilVolumeAttachment
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*VolumeAttachment)(nil)
+
+func (t *VolumeAttachment) Validate() error {
+	return ValidateVolumeAttachment(t)
+}
+
+// ValidateVolumeAttachmentUpdate validates t as an update of old: it runs the rules of ValidateVolumeAttachment
+// and the transition rules comparing the fields of t with those of old, such as immutable. A nil old
+// validates t as a new value, without the transition rules.
+func ValidateVolumeAttachmentUpdate(old, t *VolumeAttachment) error {
+	if old == nil {
+		return ValidateVolumeAttachment(t)
+	}
+
+	var errs govaliderrors.ValidationErrors
+	if err := ValidateVolumeAttachment(t); err != nil {
+		if !errors.As(err, &errs) {
+			return err
+		}
+	}
+
+	if t.Host != old.Host {
+		err := ErrVolumeAttachmentHostImmutableValidation
+		err.Value = t.Host
+		errs = append(errs, err)
+	}
+
+	if old.Device != "" && t.Device != old.Device {
+		err := ErrVolumeAttachmentDeviceImmutableOnceSetValidation
+		err.Value = t.Device
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package unit
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/templatedop/govalid/test"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func TestBinder(t *testing.T) {
+	t.Run("binds and converts values", func(t *testing.T) {
+		values := url.Values{
+			"org":     {"acme"},
+			"limit":   {"10"},
+			"active":  {"true"},
+			"timeout": {"5s"},
+			"id":      {"1", "2"},
+			"since":   {"7"},
+		}
+
+		got, err := test.BindBinderFromValues(values)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if got.Org != "acme" || got.Limit != 10 || !got.Active || got.Timeout != 5*time.Second {
+			t.Errorf("unexpected scalars: %+v", got)
+		}
+
+		if len(got.IDs) != 2 || got.IDs[0] != 1 || got.IDs[1] != 2 {
+			t.Errorf("IDs = %v, want [1 2]", got.IDs)
+		}
+
+		if got.Since == nil || *got.Since != 7 {
+			t.Errorf("Since = %v, want 7", got.Since)
+		}
+	})
+
+	t.Run("reports conversion and validation errors together", func(t *testing.T) {
+		values := url.Values{
+			"limit": {"ten"},
+			"id":    {"1", "x"},
+		}
+
+		_, err := test.BindBinderFromValues(values)
+
+		var errs govaliderrors.ValidationErrors
+		if !errors.As(err, &errs) {
+			t.Fatalf("expected ValidationErrors, got %v", err)
+		}
+
+		for _, target := range []error{
+			test.ErrBinderLimitConversionValidation,
+			test.ErrBinderIDsConversionValidation,
+			test.ErrBinderOrgRequiredValidation,
+		} {
+			if !errors.Is(err, target) {
+				t.Errorf("expected %v in %v", target, err)
+			}
+		}
+	})
+
+	t.Run("lists path parameters", func(t *testing.T) {
+		params := (&test.Binder{}).PathParams()
+		if len(params) != 1 || params[0] != "org" {
+			t.Errorf("PathParams() = %v, want [org]", params)
+		}
+	})
+}
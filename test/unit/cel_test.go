@@ -1,7 +1,9 @@
 package unit
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/templatedop/govalid/test"
 )
@@ -153,3 +155,125 @@ func TestCELCrossFieldValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestCELTypedValidation(t *testing.T) {
+	from := time.Date(2026, 7, 1, 14, 0, 0, 0, time.UTC)
+
+	valid := test.CELTypedBooking{
+		From:     from,
+		Until:    from.Add(48 * time.Hour),
+		MaxStay:  72 * time.Hour,
+		Plan:     "plan-basic",
+		Previous: "plan-free",
+		Guests:   2,
+		Rooms:    2,
+	}
+
+	tests := []struct {
+		name      string
+		modify    func(*test.CELTypedBooking)
+		expectErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(*test.CELTypedBooking) {},
+		},
+		{
+			name:      "until before from",
+			modify:    func(b *test.CELTypedBooking) { b.Until = from.Add(-time.Hour) },
+			expectErr: test.ErrCELTypedBookingFromCELValidation,
+		},
+		{
+			name:      "stay longer than the maximum",
+			modify:    func(b *test.CELTypedBooking) { b.Until = from.Add(96 * time.Hour) },
+			expectErr: test.ErrCELTypedBookingFromCELValidation,
+		},
+		{
+			name:      "plan of a named string type without prefix",
+			modify:    func(b *test.CELTypedBooking) { b.Plan = "basic" },
+			expectErr: test.ErrCELTypedBookingPlanCELValidation,
+		},
+		{
+			name:      "plan equal to the previous one",
+			modify:    func(b *test.CELTypedBooking) { b.Plan = "plan-free" },
+			expectErr: test.ErrCELTypedBookingPlanCELValidation,
+		},
+		{
+			name:      "guests of int32 above the int rooms",
+			modify:    func(b *test.CELTypedBooking) { b.Guests = 3 },
+			expectErr: test.ErrCELTypedBookingGuestsCELValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid
+			tt.modify(&data)
+
+			err := test.ValidateCELTypedBooking(&data)
+
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestCELFunctionsValidation(t *testing.T) {
+	valid := test.CELFunctions{VATNumber: "DE123456789", Used: 3, Quota: 5}
+
+	tests := []struct {
+		name      string
+		modify    func(*test.CELFunctions)
+		expectErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(*test.CELFunctions) {},
+		},
+		{
+			name:      "VAT number rejected by the Go function",
+			modify:    func(f *test.CELFunctions) { f.VATNumber = "de123456789" },
+			expectErr: test.ErrCELFunctionsVATNumberCELValidation,
+		},
+		{
+			name:      "VAT number rejected by the rest of the expression",
+			modify:    func(f *test.CELFunctions) { f.VATNumber = "XX123456789" },
+			expectErr: test.ErrCELFunctionsVATNumberCELValidation,
+		},
+		{
+			name:      "used above the quota of another field",
+			modify:    func(f *test.CELFunctions) { f.Used = 6 },
+			expectErr: test.ErrCELFunctionsUsedCELValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid
+			tt.modify(&data)
+
+			err := test.ValidateCELFunctions(&data)
+
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package unit
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+
+	"github.com/templatedop/govalid/test"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+// TestCELConformance checks that the Go code converted from the CEL expressions of CELExtensions
+// agrees with the evaluation of the expressions by the cel-go interpreter.
+func TestCELConformance(t *testing.T) {
+	rules := map[string]govaliderrors.ValidationError{
+		"Code":    test.ErrCELExtensionsCodeCELValidation,
+		"Slug":    test.ErrCELExtensionsSlugCELValidation,
+		"Title":   test.ErrCELExtensionsTitleCELValidation,
+		"Tags":    test.ErrCELExtensionsTagsCELValidation,
+		"Scores":  test.ErrCELExtensionsScoresCELValidation,
+		"Offset":  test.ErrCELExtensionsOffsetCELValidation,
+		"Ratio":   test.ErrCELExtensionsRatioCELValidation,
+		"Limits":  test.ErrCELExtensionsLimitsCELValidation,
+		"Roles":   test.ErrCELExtensionsRolesCELValidation,
+		"Start":   test.ErrCELExtensionsStartCELValidation,
+		"Timeout": test.ErrCELExtensionsTimeoutCELValidation,
+	}
+
+	tests := []struct {
+		name string
+		data test.CELExtensions
+	}{
+		{
+			name: "valid",
+			data: test.CELExtensions{
+				Code:    "ab-c",
+				Slug:    "a-b",
+				Title:   "Hello",
+				Tags:    []string{"a", "b"},
+				Scores:  []int{10, 20},
+				Offset:  3,
+				Base:    2,
+				Ratio:   1.5,
+				Limits:  map[string]int{"max_users": 10, "min_users": 1},
+				Roles:   []string{"admin", "user"},
+				Start:   time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC),
+				Timeout: 90 * time.Second,
+			},
+		},
+		{
+			name: "invalid",
+			data: test.CELExtensions{
+				Code:    "xb-c",
+				Slug:    "a-b-c-d",
+				Title:   " hello",
+				Tags:    []string{"b", "b"},
+				Scores:  []int{42, 101},
+				Offset:  -11,
+				Ratio:   math.Inf(1),
+				Limits:  map[string]int{"users": 1},
+				Roles:   []string{"root"},
+				Start:   time.Date(1999, 12, 31, 0, 0, 0, 0, time.UTC),
+				Timeout: 2 * time.Hour,
+			},
+		},
+		{
+			name: "code points and empty lists",
+			data: test.CELExtensions{
+				Code:    "AB-ü",
+				Slug:    "ää-öö",
+				Title:   "Ünïcode",
+				Ratio:   -2.5,
+				Limits:  map[string]int{"max_users": 5, "min_users": 7},
+				Start:   time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC),
+				Timeout: 1500 * time.Millisecond,
+			},
+		},
+		{
+			name: "duplicates and time zones",
+			data: test.CELExtensions{
+				Code:   "abü-",
+				Slug:   "x-y",
+				Title:  "ABBA",
+				Tags:   []string{"a", "c", "a"},
+				Scores: []int{1, 3, 5, 7},
+				Offset: 7,
+				Base:   9,
+				Ratio:  -1000,
+				Limits: map[string]int{"max_users": 3, "limit": -1},
+				Roles:  []string{"guest"},
+				Start:  time.Date(1999, 12, 31, 23, 30, 0, 0, time.FixedZone("EST", -5*60*60)),
+			},
+		},
+		{
+			name: "boundaries",
+			data: test.CELExtensions{
+				Code:    "Ab-Z",
+				Slug:    "one",
+				Title:   "Hello World",
+				Tags:    []string{"z"},
+				Scores:  []int{0, 100},
+				Offset:  12,
+				Base:    -3,
+				Ratio:   999.9,
+				Limits:  map[string]int{"max_users": 0},
+				Roles:   []string{"user", "admin", "user"},
+				Start:   time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC),
+				Timeout: time.Hour,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateCELExtensions(&tt.data)
+			this := celFields(tt.data)
+
+			for field, rule := range rules {
+				want := evalCEL(t, rule.Param, this[field], this)
+				if got := !errors.Is(err, rule); got != want {
+					t.Errorf("%s: Go = %v, cel-go = %v for %s", field, got, want, rule.Param)
+				}
+			}
+		})
+	}
+}
+
+// celFields returns the fields of the struct v by Go name, the value of this in the expressions.
+func celFields(v any) map[string]any {
+	fields := map[string]any{}
+
+	rv := reflect.ValueOf(v)
+	for i := range rv.NumField() {
+		fields[rv.Type().Field(i).Name] = rv.Field(i).Interface()
+	}
+
+	return fields
+}
+
+// evalCEL evaluates the CEL expression with the cel-go interpreter, in the environment of the generator
+// where value and this are dynamic.
+func evalCEL(t *testing.T, expression string, value any, this map[string]any) bool {
+	t.Helper()
+
+	env, err := cel.NewEnv(
+		cel.StdLib(),
+		cel.CrossTypeNumericComparisons(true),
+		cel.OptionalTypes(),
+		ext.Strings(),
+		ext.Lists(),
+		ext.Math(),
+		ext.Sets(),
+		cel.Variable("value", cel.DynType),
+		cel.Variable("this", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		t.Fatalf("failed to create CEL environment: %v", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		t.Fatalf("failed to compile %s: %v", expression, issues.Err())
+	}
+
+	program, err := env.Program(ast)
+	if err != nil {
+		t.Fatalf("failed to plan %s: %v", expression, err)
+	}
+
+	out, _, err := program.Eval(map[string]any{"value": value, "this": this})
+	if err != nil {
+		t.Fatalf("failed to evaluate %s: %v", expression, err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		t.Fatalf("%s evaluated to %v, not a boolean", expression, out)
+	}
+
+	return result
+}
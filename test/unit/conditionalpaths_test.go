@@ -0,0 +1,65 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestConditionalPathsValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    test.ConditionalPaths
+		wantErr error
+	}{
+		{
+			name: "valid - conditions on enclosing fields not met",
+			data: test.ConditionalPaths{Country: "FR", Items: []test.ConditionalPathsItem{{}}},
+		},
+		{
+			name: "valid - conditions met and fields set",
+			data: test.ConditionalPaths{
+				Country: "US",
+				Express: true,
+				Address: test.ConditionalPathsAddress{Street: "Main St", Zip: "12345"},
+				Items:   []test.ConditionalPathsItem{{Label: "box", Insurance: "full"}},
+			},
+		},
+		{
+			name:    "invalid - parent field condition",
+			data:    test.ConditionalPaths{Country: "US"},
+			wantErr: test.ErrConditionalPathsAddressZipRequiredIfValidation,
+		},
+		{
+			name: "invalid - root nested field present",
+			data: test.ConditionalPaths{
+				Address: test.ConditionalPathsAddress{Street: "Main St"},
+				Items:   []test.ConditionalPathsItem{{}},
+			},
+			wantErr: test.ErrConditionalPathsItemsiLabelRequiredWithValidation,
+		},
+		{
+			name:    "invalid - root field condition",
+			data:    test.ConditionalPaths{Items: []test.ConditionalPathsItem{{Insurance: "full"}}},
+			wantErr: test.ErrConditionalPathsItemsiInsuranceExcludedIfValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateConditionalPaths(&tt.data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestConstArgsValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      test.ConstArgs
+		expectErr error
+	}{
+		{
+			name: "valid",
+			data: test.ConstArgs{Name: "alice", Retries: test.ConstArgsMaxRetries, Layout: time.RFC3339},
+		},
+		{
+			name: "bare word",
+			data: test.ConstArgs{Name: "bob", Layout: "iso"},
+		},
+		{
+			name:      "name longer than the constant",
+			data:      test.ConstArgs{Name: "alexander", Layout: time.Kitchen},
+			expectErr: test.ErrConstArgsNameMaxLengthValidation,
+		},
+		{
+			name:      "retries above the constant",
+			data:      test.ConstArgs{Name: "bob", Retries: 4, Layout: "iso"},
+			expectErr: test.ErrConstArgsRetriesLTEValidation,
+		},
+		{
+			name:      "name of the constant instead of its value",
+			data:      test.ConstArgs{Name: "bob", Layout: "time.RFC3339"},
+			expectErr: test.ErrConstArgsLayoutOneofValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateConstArgs(&tt.data)
+
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
 package unit
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	"github.com/templatedop/govalid/test"
 )
@@ -78,3 +80,49 @@ func TestEnumValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestEnumConstValidation(t *testing.T) {
+	tests := []struct {
+		name      string
+		data      test.EnumConst
+		expectErr error
+	}{
+		{
+			name: "valid constants",
+			data: test.EnumConst{Status: test.EnumStatusInactive, Weekday: time.Friday},
+		},
+		{
+			name:      "status not a constant",
+			data:      test.EnumConst{Status: "archived", Weekday: time.Monday},
+			expectErr: test.ErrEnumConstStatusEnumValidation,
+		},
+		{
+			name:      "empty status",
+			data:      test.EnumConst{Weekday: time.Monday},
+			expectErr: test.ErrEnumConstStatusEnumValidation,
+		},
+		{
+			name:      "weekday from another package out of range",
+			data:      test.EnumConst{Status: test.EnumStatusActive, Weekday: time.Weekday(7)},
+			expectErr: test.ErrEnumConstWeekdayEnumValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateEnumConst(&tt.data)
+
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestExprValidation(t *testing.T) {
+	now := time.Now()
+
+	tests := []struct {
+		name      string
+		data      test.ExprHeaders
+		expectErr error
+	}{
+		{
+			name: "valid",
+			data: test.ExprHeaders{Name: "x-trace", Tags: []string{"a"}, MaxTags: 1, From: now, Until: now.Add(time.Hour)},
+		},
+		{
+			name: "open window",
+			data: test.ExprHeaders{Name: "x-trace", From: now},
+		},
+		{
+			name:      "name without prefix",
+			data:      test.ExprHeaders{Name: "trace"},
+			expectErr: test.ErrExprHeadersNameExprValidation,
+		},
+		{
+			name:      "more tags than allowed",
+			data:      test.ExprHeaders{Name: "x-trace", Tags: []string{"a", "b"}, MaxTags: 1},
+			expectErr: test.ErrExprHeadersNameExprValidation,
+		},
+		{
+			name:      "window ending before it starts",
+			data:      test.ExprHeaders{Name: "x-trace", From: now, Until: now.Add(-time.Hour)},
+			expectErr: test.ErrExprHeadersExprValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateExprHeaders(&tt.data)
+
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/templatedop/govalid/test"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func TestFieldComparisonValidation(t *testing.T) {
+	opens := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+	closes := opens.Add(8 * time.Hour)
+
+	valid := func() test.FieldComparison {
+		email, confirm := "a@example.com", "a@example.com"
+
+		return test.FieldComparison{
+			Email:           &email,
+			ConfirmEmail:    &confirm,
+			Password:        "secret",
+			ConfirmPassword: "secret",
+			CurrentPin:      "1234",
+			NewPin:          "4321",
+			Opens:           opens,
+			Closes:          closes,
+			MinAge:          18,
+			MaxAge:          18,
+			Timeout:         time.Minute,
+			Retry:           time.Second,
+			Capacity:        10,
+			Booked:          10,
+			Slots: []test.FieldComparisonSlot{
+				{At: opens, Code: "a"},
+				{At: closes, Code: "b"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		modify  func(*test.FieldComparison)
+		wantErr error
+	}{
+		{
+			name:   "valid",
+			modify: func(*test.FieldComparison) {},
+		},
+		{
+			name:    "invalid - eqfield",
+			modify:  func(f *test.FieldComparison) { f.ConfirmPassword = "other" },
+			wantErr: test.ErrFieldComparisonConfirmPasswordEqFieldValidation,
+		},
+		{
+			name:    "invalid - nefield",
+			modify:  func(f *test.FieldComparison) { f.NewPin = f.CurrentPin },
+			wantErr: test.ErrFieldComparisonNewPinNeFieldValidation,
+		},
+		{
+			name:    "invalid - gtfield with equal times",
+			modify:  func(f *test.FieldComparison) { f.Closes = opens },
+			wantErr: test.ErrFieldComparisonClosesGtFieldValidation,
+		},
+		{
+			name:    "invalid - gtefield",
+			modify:  func(f *test.FieldComparison) { f.MaxAge = 17 },
+			wantErr: test.ErrFieldComparisonMaxAgeGteFieldValidation,
+		},
+		{
+			name:    "invalid - ltfield with durations",
+			modify:  func(f *test.FieldComparison) { f.Retry = time.Minute },
+			wantErr: test.ErrFieldComparisonRetryLtFieldValidation,
+		},
+		{
+			name:    "invalid - ltefield",
+			modify:  func(f *test.FieldComparison) { f.Booked = 11 },
+			wantErr: test.ErrFieldComparisonBookedLteFieldValidation,
+		},
+		{
+			name:   "valid - eqfield with nil pointer",
+			modify: func(f *test.FieldComparison) { f.ConfirmEmail = nil },
+		},
+		{
+			name: "invalid - eqfield with pointers to different values",
+			modify: func(f *test.FieldComparison) {
+				other := "b@example.com"
+				f.ConfirmEmail = &other
+			},
+			wantErr: test.ErrFieldComparisonConfirmEmailEqFieldValidation,
+		},
+		{
+			name:    "invalid - gtecsfield in slice",
+			modify:  func(f *test.FieldComparison) { f.Slots[0].At = opens.Add(-time.Minute) },
+			wantErr: test.ErrFieldComparisonSlotsiAtGteCSFieldValidation,
+		},
+		{
+			name:    "invalid - ltecsfield in slice",
+			modify:  func(f *test.FieldComparison) { f.Slots[1].At = closes.Add(time.Minute) },
+			wantErr: test.ErrFieldComparisonSlotsiAtLteCSFieldValidation,
+		},
+		{
+			name:    "invalid - necsfield in slice",
+			modify:  func(f *test.FieldComparison) { f.Slots[1].Code = f.Password },
+			wantErr: test.ErrFieldComparisonSlotsiCodeNeCSFieldValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := valid()
+			tt.modify(&data)
+
+			err := test.ValidateFieldComparison(&data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFieldComparisonDiveErrorPaths(t *testing.T) {
+	opens := time.Date(2025, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	data := test.FieldComparison{
+		Password: "secret",
+		Opens:    opens,
+		Closes:   opens.Add(time.Hour),
+		Slots: []test.FieldComparisonSlot{
+			{At: opens.Add(-time.Minute), Code: "a"},
+			{At: opens, Code: "b"},
+			{At: opens.Add(2 * time.Hour), Code: "c"},
+		},
+	}
+
+	err := test.ValidateFieldComparison(&data)
+
+	var errs govaliderrors.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("expected ValidationErrors, got %v", err)
+	}
+
+	slots := errs.Tree().Lookup("FieldComparison.Slots")
+	if slots == nil {
+		t.Fatalf("expected errors under FieldComparison.Slots, got %v", errs)
+	}
+
+	for index, want := range map[string]string{"0": "gtecsfield", "2": "ltecsfield"} {
+		node := slots.Fields[index]
+		if node == nil || node.Fields["At"] == nil || len(node.Fields["At"].Errors) != 1 || node.Fields["At"].Errors[0].Type != want {
+			t.Errorf("expected a %s error for Slots[%s].At, got %+v", want, index, node)
+		}
+	}
+
+	if len(slots.Fields) != 2 {
+		t.Errorf("expected the errors of the elements 0 and 2 only, got %v", errs)
+	}
+
+	if !errors.Is(err, test.ErrFieldComparisonSlotsiAtGteCSFieldValidation) {
+		t.Errorf("expected the error of Slots[0] to match its declared error, got %v", err)
+	}
+}
@@ -0,0 +1,69 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestFieldGroupValidation(t *testing.T) {
+	account := "DE89370400440532013000"
+
+	tests := []struct {
+		name    string
+		data    test.Checkout
+		wantErr error
+	}{
+		{
+			name: "valid",
+			data: test.Checkout{CardToken: "tok", Coupon: "SAVE10", Street: "Main St", City: "Springfield", Email: "a@example.com"},
+		},
+		{
+			name: "valid - struct field set",
+			data: test.Checkout{Wallet: test.CheckoutWallet{Provider: "pay"}, Phone: "123"},
+		},
+		{
+			name:    "invalid - none of exactly one",
+			data:    test.Checkout{Email: "a@example.com"},
+			wantErr: test.ErrCheckoutExactlyOneOfValidation,
+		},
+		{
+			name:    "invalid - two of exactly one",
+			data:    test.Checkout{CardToken: "tok", BankAccount: &account, Email: "a@example.com"},
+			wantErr: test.ErrCheckoutExactlyOneOfValidation,
+		},
+		{
+			name:    "invalid - mutually exclusive",
+			data:    test.Checkout{CardToken: "tok", Coupon: "SAVE10", GiftCard: "GIFT", Email: "a@example.com"},
+			wantErr: test.ErrCheckoutAtMostOneOfValidation,
+		},
+		{
+			name:    "invalid - partial group",
+			data:    test.Checkout{CardToken: "tok", Street: "Main St", Email: "a@example.com"},
+			wantErr: test.ErrCheckoutAllOrNoneOfValidation,
+		},
+		{
+			name:    "invalid - none of at least one",
+			data:    test.Checkout{CardToken: "tok"},
+			wantErr: test.ErrCheckoutOneOfRequiredValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateCheckout(&tt.data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+	"github.com/templatedop/govalid/validation/fieldmask"
+)
+
+func TestValidateFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    test.PartialUpdate
+		patch   string
+		wantErr error
+	}{
+		{
+			name:  "valid - required field outside the patch",
+			data:  test.PartialUpdate{Email: "gopher@example.com"},
+			patch: `{"email": "gopher@example.com"}`,
+		},
+		{
+			name:    "invalid - patched field",
+			data:    test.PartialUpdate{Name: "Gopher", Email: "invalid"},
+			patch:   `{"email": "invalid"}`,
+			wantErr: test.ErrPartialUpdateEmailEmailValidation,
+		},
+		{
+			name:    "invalid - patched field a conditional rule depends on",
+			data:    test.PartialUpdate{Status: "active"},
+			patch:   `{"status": "active"}`,
+			wantErr: test.ErrPartialUpdateActivatedByRequiredIfValidation,
+		},
+		{
+			name:    "invalid - removed required field",
+			data:    test.PartialUpdate{},
+			patch:   `{"name": null}`,
+			wantErr: test.ErrPartialUpdateNameRequiredValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mask, err := fieldmask.FromMergePatch([]byte(tt.patch))
+			if err != nil {
+				t.Fatalf("failed to derive the mask: %v", err)
+			}
+
+			err = test.ValidatePartialUpdateFields(&tt.data, mask...)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if err := test.ValidatePartialUpdateFields(&test.PartialUpdate{}, "Name"); !errors.Is(err, test.ErrPartialUpdateNameRequiredValidation) {
+		t.Errorf("expected Go field names to be accepted, got %v", err)
+	}
+}
@@ -0,0 +1,72 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestValidateGroups(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    test.Membership
+		groups  []string
+		wantErr error
+	}{
+		{
+			name: "valid - grouped rules skipped without groups",
+			data: test.Membership{Email: "gopher@example.com"},
+		},
+		{
+			name:    "invalid - ungrouped rules always run",
+			data:    test.Membership{Email: "invalid"},
+			groups:  []string{"create"},
+			wantErr: test.ErrMembershipEmailEmailValidation,
+		},
+		{
+			name:    "invalid - create",
+			data:    test.Membership{Email: "gopher@example.com"},
+			groups:  []string{"create"},
+			wantErr: test.ErrMembershipPasswordRequiredValidation,
+		},
+		{
+			name:   "valid - create",
+			data:   test.Membership{Email: "gopher@example.com", Password: "password"},
+			groups: []string{"create"},
+		},
+		{
+			name:    "invalid - update",
+			data:    test.Membership{Email: "gopher@example.com", Password: "short"},
+			groups:  []string{"update"},
+			wantErr: test.ErrMembershipIDRequiredValidation,
+		},
+		{
+			name:    "invalid - rule in several groups",
+			data:    test.Membership{ID: "1", Password: "short"},
+			groups:  []string{"update"},
+			wantErr: test.ErrMembershipPasswordMinLengthValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateMembershipGroups(&tt.data, tt.groups...)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if err := test.ValidateMembership(&test.Membership{Email: "gopher@example.com"}); err != nil {
+		t.Errorf("grouped rules must not run in Validate, got %v", err)
+	}
+}
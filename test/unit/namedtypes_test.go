@@ -0,0 +1,84 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestNamedTypesValidation(t *testing.T) {
+	backupEmail := test.NamedEmail("invalid")
+
+	tests := []struct {
+		name      string
+		data      test.NamedTypes
+		expectErr error
+	}{
+		{
+			name: "valid",
+			data: test.NamedTypes{Email: "user@example.com", Tags: test.NamedTags{"a", "b"}},
+		},
+		{
+			name:      "rule of the type",
+			data:      test.NamedTypes{Email: "invalid", Tags: test.NamedTags{"a"}},
+			expectErr: test.ErrNamedTypesEmailEmailValidation,
+		},
+		{
+			name:      "inherited rule",
+			data:      test.NamedTypes{Email: "user@example.com"},
+			expectErr: test.ErrNamedTypesTagsMinItemsValidation,
+		},
+		{
+			name:      "rule of the field overriding that of the type",
+			data:      test.NamedTypes{Email: "user@example.com", Tags: test.NamedTags{"a", "b", "c"}},
+			expectErr: test.ErrNamedTypesTagsMaxItemsValidation,
+		},
+		{
+			name: "nil pointer to the type",
+			data: test.NamedTypes{Email: "user@example.com", Tags: test.NamedTags{"a"}},
+		},
+		{
+			name:      "rule of the type on a pointer",
+			data:      test.NamedTypes{Email: "user@example.com", Tags: test.NamedTags{"a"}, BackupEmail: &backupEmail},
+			expectErr: test.ErrNamedTypesBackupEmailEmailValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateNamedTypes(&tt.data)
+
+			if tt.expectErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.expectErr) {
+				t.Errorf("expected %v, got %v", tt.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestNamedTypeValue(t *testing.T) {
+	if err := test.NamedEmail("user@example.com").Validate(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if err := test.ValidateNamedEmail("invalid"); !errors.Is(err, test.ErrNamedEmailEmailValidation) {
+		t.Errorf("expected %v, got %v", test.ErrNamedEmailEmailValidation, err)
+	}
+
+	// The field overrides maxitems, the type itself allows 3 tags
+	if err := test.ValidateNamedTags(test.NamedTags{"a", "b", "c"}); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+
+	if err := test.ValidateNamedTags(nil); !errors.Is(err, test.ErrNamedTagsMinItemsValidation) {
+		t.Errorf("expected %v, got %v", test.ErrNamedTagsMinItemsValidation, err)
+	}
+}
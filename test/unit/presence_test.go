@@ -0,0 +1,74 @@
+package unit
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestPresenceValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload string
+		wantErr error
+	}{
+		{
+			name:    "valid - zero quantity present",
+			payload: `{"quantity": 0, "discount": null}`,
+		},
+		{
+			name:    "invalid - quantity missing",
+			payload: `{"discount": 5}`,
+			wantErr: test.ErrPresenceQuantityRequiredValidation,
+		},
+		{
+			name:    "invalid - quantity null",
+			payload: `{"quantity": null, "discount": 5}`,
+			wantErr: test.ErrPresenceQuantityRequiredValidation,
+		},
+		{
+			name:    "invalid - nullable discount missing",
+			payload: `{"quantity": 1}`,
+			wantErr: test.ErrPresenceDiscountRequiredValidation,
+		},
+		{
+			name:    "valid - note missing",
+			payload: `{"quantity": 1, "discount": 5}`,
+		},
+		{
+			name:    "invalid - note null",
+			payload: `{"quantity": 1, "discount": 5, "note": null}`,
+			wantErr: test.ErrPresenceNoteNotNullValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var data test.Presence
+			if err := json.Unmarshal([]byte(tt.payload), &data); err != nil {
+				t.Fatalf("failed to decode payload: %v", err)
+			}
+
+			err := test.ValidatePresence(&data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	t.Run("falls back to the zero value when not decoded", func(t *testing.T) {
+		if err := test.ValidatePresence(&test.Presence{}); !errors.Is(err, test.ErrPresenceQuantityRequiredValidation) {
+			t.Errorf("expected %v, got %v", test.ErrPresenceQuantityRequiredValidation, err)
+		}
+	})
+}
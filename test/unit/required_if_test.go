@@ -1,6 +1,7 @@
 package unit
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/templatedop/govalid/test"
@@ -43,3 +44,52 @@ func TestRequiredIfValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestRequiredIfTypedValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    test.RequiredIfTyped
+		wantErr error
+	}{
+		{
+			name: "valid - conditions not met",
+			data: test.RequiredIfTyped{Count: 2},
+		},
+		{
+			name: "valid - required fields set",
+			data: test.RequiredIfTyped{Count: 3, Reason: "limit", Active: true, Level: test.RequiredIfLevelHigh, Owner: "ops"},
+		},
+		{
+			name:    "invalid - int condition met",
+			data:    test.RequiredIfTyped{Count: 3},
+			wantErr: test.ErrRequiredIfTypedReasonRequiredIfValidation,
+		},
+		{
+			name:    "invalid - all pairs met",
+			data:    test.RequiredIfTyped{Active: true, Level: test.RequiredIfLevelHigh},
+			wantErr: test.ErrRequiredIfTypedOwnerRequiredIfValidation,
+		},
+		{
+			name:    "invalid - bool condition of required_unless not met",
+			data:    test.RequiredIfTyped{Active: true},
+			wantErr: test.ErrRequiredIfTypedOwnerRequiredUnlessValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateRequiredIfTyped(&tt.data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func TestStructLevelValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    test.DateRange
+		wantErr error
+	}{
+		{
+			name: "valid",
+			data: test.DateRange{Start: 1, End: 2},
+		},
+		{
+			name:    "invalid - struct-level rule",
+			data:    test.DateRange{Start: 2, End: 1},
+			wantErr: test.ErrDateRangeCELValidation,
+		},
+		{
+			name:    "invalid - rule applied to each field",
+			data:    test.DateRange{End: 1},
+			wantErr: test.ErrDateRangeStartRequiredValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateDateRange(&tt.data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	var errs govaliderrors.ValidationErrors
+	if err := test.ValidateDateRange(&test.DateRange{Start: 2, End: 1}); !errors.As(err, &errs) || len(errs) != 1 || errs[0].Path != "DateRange" {
+		t.Errorf("expected a single error at the struct path, got %v", err)
+	}
+}
@@ -0,0 +1,152 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func TestValidateUpdate(t *testing.T) {
+	old := &test.Volume{ID: "a", Generation: 2, Labels: map[string]string{"app": "web"}}
+
+	tests := []struct {
+		name    string
+		old     *test.Volume
+		data    test.Volume
+		wantErr error
+	}{
+		{
+			name: "valid - unchanged",
+			old:  old,
+			data: test.Volume{ID: "a", Generation: 2, Labels: map[string]string{"app": "web"}},
+		},
+		{
+			name: "valid - set once and increase",
+			old:  old,
+			data: test.Volume{ID: "a", Node: "n1", Generation: 3, Labels: map[string]string{"app": "web"}},
+		},
+		{
+			name: "valid - creation without old",
+			data: test.Volume{ID: "b"},
+		},
+		{
+			name:    "invalid - creation without old still runs the rules",
+			data:    test.Volume{},
+			wantErr: test.ErrVolumeIDRequiredValidation,
+		},
+		{
+			name:    "invalid - immutable changed",
+			old:     old,
+			data:    test.Volume{ID: "b", Generation: 2, Labels: map[string]string{"app": "web"}},
+			wantErr: test.ErrVolumeIDImmutableValidation,
+		},
+		{
+			name:    "invalid - immutable map changed",
+			old:     old,
+			data:    test.Volume{ID: "a", Generation: 2, Labels: map[string]string{"app": "api"}},
+			wantErr: test.ErrVolumeLabelsImmutableValidation,
+		},
+		{
+			name:    "invalid - changed once set",
+			old:     &test.Volume{ID: "a", Node: "n1", Generation: 2, Labels: map[string]string{"app": "web"}},
+			data:    test.Volume{ID: "a", Node: "n2", Generation: 2, Labels: map[string]string{"app": "web"}},
+			wantErr: test.ErrVolumeNodeImmutableOnceSetValidation,
+		},
+		{
+			name:    "invalid - transition rule",
+			old:     old,
+			data:    test.Volume{ID: "a", Generation: 1, Labels: map[string]string{"app": "web"}},
+			wantErr: test.ErrVolumeGenerationCELValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateVolumeUpdate(tt.old, &tt.data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+
+	if err := test.ValidateVolume(&test.Volume{ID: "a", Generation: -1}); err != nil {
+		t.Errorf("transition rules must not run in Validate, got %v", err)
+	}
+}
+
+func TestValidateUpdateNilNestedStruct(t *testing.T) {
+	attached := func(host, device string) test.Volume {
+		return test.Volume{ID: "a", Attachment: &test.VolumeAttachment{Host: host, Device: device}}
+	}
+
+	tests := []struct {
+		name    string
+		old     test.Volume
+		data    test.Volume
+		wantErr []error
+	}{
+		{
+			name: "valid - unset in both",
+			old:  test.Volume{ID: "a"},
+			data: test.Volume{ID: "a"},
+		},
+		{
+			name:    "invalid - nil to set changes the immutable field",
+			old:     test.Volume{ID: "a"},
+			data:    attached("h1", "sda"),
+			wantErr: []error{test.ErrVolumeAttachmentHostImmutableValidation},
+		},
+		{
+			name:    "invalid - set to nil changes the immutable field and the field set once",
+			old:     attached("h1", "sda"),
+			data:    test.Volume{ID: "a"},
+			wantErr: []error{test.ErrVolumeAttachmentHostImmutableValidation, test.ErrVolumeAttachmentDeviceImmutableOnceSetValidation},
+		},
+		{
+			name:    "invalid - set to nil changes the immutable field only, the other one was not set",
+			old:     attached("h1", ""),
+			data:    test.Volume{ID: "a"},
+			wantErr: []error{test.ErrVolumeAttachmentHostImmutableValidation},
+		},
+		{
+			name:    "invalid - changed in both",
+			old:     attached("h1", "sda"),
+			data:    attached("h2", "sdb"),
+			wantErr: []error{test.ErrVolumeAttachmentHostImmutableValidation, test.ErrVolumeAttachmentDeviceImmutableOnceSetValidation},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateVolumeUpdate(&tt.old, &tt.data)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			var errs govaliderrors.ValidationErrors
+			if !errors.As(err, &errs) || len(errs) != len(tt.wantErr) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantErr), err)
+			}
+
+			for _, want := range tt.wantErr {
+				if !errors.Is(err, want) {
+					t.Errorf("expected %v, got %v", want, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package unit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/templatedop/govalid/test"
+)
+
+func TestWhenValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    test.Guarded
+		wantErr error
+	}{
+		{
+			name: "valid - guards do not hold",
+			data: test.Guarded{Country: "FR", Zip: "75001-A", Type: "person", Age: 30, Referees: []test.GuardedReferee{{Email: "invalid"}}},
+		},
+		{
+			name: "valid - guards hold and rules pass",
+			data: test.Guarded{Country: "US", Zip: "12345", Type: "business", Age: 30, Company: "Acme", Referees: []test.GuardedReferee{{Email: "a@example.com"}}},
+		},
+		{
+			name:    "invalid - comparison guard holds",
+			data:    test.Guarded{Country: "US", Zip: "1234a"},
+			wantErr: test.ErrGuardedZipNumericValidation,
+		},
+		{
+			name:    "invalid - CEL guard holds",
+			data:    test.Guarded{Type: "business", Age: 18},
+			wantErr: test.ErrGuardedCompanyRequiredValidation,
+		},
+		{
+			name:    "invalid - root guard holds",
+			data:    test.Guarded{Type: "business", Age: 17, Referees: []test.GuardedReferee{{Email: "invalid"}}},
+			wantErr: test.ErrGuardedRefereesiEmailEmailValidation,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := test.ValidateGuarded(&tt.data)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+
+				return
+			}
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("expected %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
@@ -16,6 +16,9 @@ type ValidationError struct {
 	Value any
 	// Reason is a human-readable message explaining why the validation failed.
 	Reason string
+	// Param is the argument of the failed rule, e.g., "50" for maxlength=50. It is empty for rules without arguments.
+	// The fields of the rules over several fields are listed as in the marker, e.g., "FirstName LastName".
+	Param string
 }
 
 // ValidationErrors is a slice of ValidationError, representing a collection of validation errors.
@@ -56,11 +59,47 @@ func (e ValidationError) Error() string {
 }
 
 // Is implements error matching for ValidationError.
-// It allows errors.Is to work with ValidationError instances.
+// It allows errors.Is to work with ValidationError instances. The [i] of the paths of the errors declared
+// for the elements of collections matches any index, so the errors of each element match them.
 func (e ValidationError) Is(target error) bool {
 	if ve, ok := target.(ValidationError); ok {
-		return e.Path == ve.Path && e.Type == ve.Type && e.Reason == ve.Reason
+		return matchPath(ve.Path, e.Path) && e.Type == ve.Type && e.Reason == ve.Reason
 	}
 
 	return false
 }
+
+// AtIndex returns the error for the element at index of a collection, replacing the first [i] of its
+// Path with the index, e.g., "Person.Addresses[i].Street" becomes "Person.Addresses[2].Street".
+func (e ValidationError) AtIndex(index int) ValidationError {
+	e.Path = strings.Replace(e.Path, "[i]", fmt.Sprintf("[%d]", index), 1)
+
+	return e
+}
+
+// matchPath reports whether path is the path pattern, where [i] stands for any index.
+func matchPath(pattern, path string) bool {
+	for {
+		i := strings.Index(pattern, "[i]")
+		if i < 0 {
+			return pattern == path
+		}
+
+		if !strings.HasPrefix(path, pattern[:i+1]) {
+			return false
+		}
+
+		path = path[i+1:]
+
+		end := strings.IndexByte(path, ']')
+		if end < 0 {
+			return false
+		}
+
+		if index := path[:end]; index != "i" && (index == "" || strings.Trim(index, "0123456789") != "") {
+			return false
+		}
+
+		pattern, path = pattern[i+3:], path[end+1:]
+	}
+}
@@ -0,0 +1,43 @@
+package errors_test
+
+import (
+	"errors"
+	"testing"
+
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func TestValidationErrorAtIndex(t *testing.T) {
+	declared := govaliderrors.ValidationError{Path: "Person.Addresses[i].Street", Type: "required", Reason: "field Street is required"}
+
+	err := declared.AtIndex(2)
+	if err.Path != "Person.Addresses[2].Street" {
+		t.Errorf("AtIndex(2).Path = %q, want %q", err.Path, "Person.Addresses[2].Street")
+	}
+
+	if declared.Path != "Person.Addresses[i].Street" {
+		t.Errorf("AtIndex modified the declared error: %q", declared.Path)
+	}
+
+	tests := map[string]struct {
+		err  govaliderrors.ValidationError
+		want bool
+	}{
+		"index":          {err: err, want: true},
+		"declared":       {err: declared, want: true},
+		"other field":    {err: govaliderrors.ValidationError{Path: "Person.Addresses[2].City", Type: "required", Reason: "field Street is required"}, want: false},
+		"not an index":   {err: govaliderrors.ValidationError{Path: "Person.Addresses[x].Street", Type: "required", Reason: "field Street is required"}, want: false},
+		"empty index":    {err: govaliderrors.ValidationError{Path: "Person.Addresses[].Street", Type: "required", Reason: "field Street is required"}, want: false},
+		"other type":     {err: govaliderrors.ValidationError{Path: "Person.Addresses[2].Street", Type: "email", Reason: "field Street is required"}, want: false},
+		"collection":     {err: govaliderrors.ValidationError{Path: "Person.Addresses", Type: "required", Reason: "field Street is required"}, want: false},
+		"in error slice": {err: govaliderrors.ValidationError{Path: "Person.Addresses[10].Street", Type: "required", Reason: "field Street is required"}, want: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := errors.Is(govaliderrors.ValidationErrors{tt.err}, declared); got != tt.want {
+				t.Errorf("errors.Is(%q, %q) = %v, want %v", tt.err.Path, declared.Path, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Package grpcerrors converts validation errors into gRPC error details.
+//
+// It lives apart from the errors package so that generated validators do not pull
+// the protobuf runtime into programs that never talk gRPC.
+package grpcerrors
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+// BadRequest converts errs into a google.rpc.BadRequest with one FieldViolation per error.
+// The violation's Field is derived with FieldPath and its Description is the error's Reason.
+// The FieldViolation of the errdetails version govalid depends on has no reason field; attach
+// the ErrorInfos of errs to the status to report their reason codes.
+func BadRequest(errs govaliderrors.ValidationErrors) *errdetails.BadRequest {
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(errs))
+
+	for _, err := range errs {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{
+			Field:       FieldPath(err.Path),
+			Description: err.Reason,
+		})
+	}
+
+	return &errdetails.BadRequest{FieldViolations: violations}
+}
+
+// ErrorInfos converts errs into one google.rpc.ErrorInfo per error, in the order of the field
+// violations of BadRequest. The Reason is the ReasonCode of the error's Type and the Metadata
+// holds the field path of the violation under "field", and the error's Param under "param"
+// when set. domain is the service the reasons belong to, e.g., "users.example.com".
+func ErrorInfos(errs govaliderrors.ValidationErrors, domain string) []*errdetails.ErrorInfo {
+	infos := make([]*errdetails.ErrorInfo, 0, len(errs))
+
+	for _, err := range errs {
+		metadata := map[string]string{"field": FieldPath(err.Path)}
+		if err.Param != "" {
+			metadata["param"] = err.Param
+		}
+
+		infos = append(infos, &errdetails.ErrorInfo{
+			Reason:   ReasonCode(err.Type),
+			Domain:   domain,
+			Metadata: metadata,
+		})
+	}
+
+	return infos
+}
+
+// FromError converts err into a google.rpc.BadRequest if it is, or wraps, a ValidationErrors
+// or a single ValidationError. It reports false for any other error, such as the ErrNil
+// errors returned for nil inputs.
+func FromError(err error) (*errdetails.BadRequest, bool) {
+	var verrs govaliderrors.ValidationErrors
+	if errors.As(err, &verrs) {
+		return BadRequest(verrs), true
+	}
+
+	var verr govaliderrors.ValidationError
+	if errors.As(err, &verr) {
+		return BadRequest(govaliderrors.ValidationErrors{verr}), true
+	}
+
+	return nil, false
+}
+
+// FieldPath converts a ValidationError path into a FieldViolation field path.
+// Generated paths start with the name of the validated type, e.g., "CreateUserRequest.Name",
+// while field violations are relative to the request message, so the first segment is dropped.
+// The Go field names of the remaining segments are converted to the proto field names they
+// are generated from, and indices are kept, e.g., "CreateUserRequest.HomeAddresses[1].ZipCode"
+// yields "home_addresses[1].zip_code". A path of a single segment, such as the path of the errors
+// of struct-level rules, has no root to drop and is converted as is.
+func FieldPath(path string) string {
+	_, rest, found := strings.Cut(path, ".")
+	if !found {
+		rest = path
+	}
+
+	parts := strings.Split(rest, ".")
+	for i, part := range parts {
+		name, index, _ := strings.Cut(part, "[")
+		if index != "" {
+			index = "[" + index
+		}
+
+		parts[i] = protoName(name) + index
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// protoName converts the Go name of a field of a protoc-gen-go message into its proto field
+// name, e.g., "ZipCode" into "zip_code". Acronyms are kept together, so "UserID" yields "user_id".
+func protoName(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) && runes[i-1] != '_' {
+			prev := runes[i-1]
+			if !unicode.IsUpper(prev) || i+1 < len(runes) && unicode.IsLower(runes[i+1]) {
+				b.WriteByte('_')
+			}
+		}
+
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+// ReasonCode converts a validation type such as "required_if" or "maxlength" into the
+// UPPER_SNAKE_CASE reason code expected by google.rpc error details, e.g., "REQUIRED_IF".
+func ReasonCode(typ string) string {
+	return strings.ToUpper(strings.ReplaceAll(typ, "-", "_"))
+}
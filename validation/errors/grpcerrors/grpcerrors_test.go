@@ -0,0 +1,118 @@
+package grpcerrors_test
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"testing"
+
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/errors/grpcerrors"
+)
+
+func TestFromError(t *testing.T) {
+	errs := govaliderrors.ValidationErrors{
+		{Path: "CreateUserRequest.Name", Type: "required", Reason: "field Name is required"},
+		{Path: "CreateUserRequest.HomeAddresses[1].ZipCode", Type: "maxlength", Reason: "field ZipCode must have a maximum length of 10"},
+		{Path: "CreateUserRequest.Nickname", Type: "required_if", Reason: "field Nickname is required when Kind equals \"person\""},
+	}
+
+	tests := map[string]struct {
+		err  error
+		want int
+		ok   bool
+	}{
+		"validation errors":         {err: errs, want: 3, ok: true},
+		"wrapped validation errors": {err: fmt.Errorf("create user: %w", errs), want: 3, ok: true},
+		"single validation error":   {err: errs[0], want: 1, ok: true},
+		"other error":               {err: errors.New("input CreateUserRequest is nil"), ok: false},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			br, ok := grpcerrors.FromError(tt.err)
+			if ok != tt.ok {
+				t.Fatalf("FromError() ok = %v, want %v", ok, tt.ok)
+			}
+
+			if !ok {
+				return
+			}
+
+			if got := len(br.GetFieldViolations()); got != tt.want {
+				t.Fatalf("expected %d field violations, got %d", tt.want, got)
+			}
+		})
+	}
+
+	br := grpcerrors.BadRequest(errs)
+	want := []struct {
+		field, description string
+	}{
+		{"name", "field Name is required"},
+		{"home_addresses[1].zip_code", "field ZipCode must have a maximum length of 10"},
+		{"nickname", "field Nickname is required when Kind equals \"person\""},
+	}
+
+	for i, v := range br.GetFieldViolations() {
+		if v.GetField() != want[i].field || v.GetDescription() != want[i].description {
+			t.Errorf("violation %d = {%q, %q}, want %+v", i, v.GetField(), v.GetDescription(), want[i])
+		}
+	}
+}
+
+func TestFieldPath(t *testing.T) {
+	tests := map[string]string{
+		"CreateUserRequest":                    "create_user_request",
+		"Email":                                "email",
+		"CreateUserRequest.Name":               "name",
+		"CreateUserRequest.UserID":             "user_id",
+		"CreateUserRequest.XId":                "x_id",
+		"CreateUserRequest.Field_1":            "field_1",
+		"CreateUserRequest.Items[0].SkuCode":   "items[0].sku_code",
+		"CreateUserRequest.Labels[env].Value":  "labels[env].value",
+		"CreateUserRequest.HTTPServer.Timeout": "http_server.timeout",
+	}
+
+	for path, want := range tests {
+		if got := grpcerrors.FieldPath(path); got != want {
+			t.Errorf("FieldPath(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestErrorInfos(t *testing.T) {
+	errs := govaliderrors.ValidationErrors{
+		{Path: "CreateUserRequest.Name", Type: "required", Reason: "field Name is required"},
+		{Path: "CreateUserRequest.HomeAddresses[1].ZipCode", Type: "maxlength", Reason: "field ZipCode must have a maximum length of 10", Param: "10"},
+	}
+
+	infos := grpcerrors.ErrorInfos(errs, "users.example.com")
+	if len(infos) != len(errs) {
+		t.Fatalf("expected %d error infos, got %d", len(errs), len(infos))
+	}
+
+	want := []struct {
+		reason   string
+		metadata map[string]string
+	}{
+		{"REQUIRED", map[string]string{"field": "name"}},
+		{"MAXLENGTH", map[string]string{"field": "home_addresses[1].zip_code", "param": "10"}},
+	}
+
+	for i, info := range infos {
+		if info.GetReason() != want[i].reason || info.GetDomain() != "users.example.com" {
+			t.Errorf("info %d = {%q, %q}, want {%q, %q}", i, info.GetReason(), info.GetDomain(), want[i].reason, "users.example.com")
+		}
+
+		if !maps.Equal(info.GetMetadata(), want[i].metadata) {
+			t.Errorf("info %d metadata = %v, want %v", i, info.GetMetadata(), want[i].metadata)
+		}
+	}
+}
+
+func TestReasonCode(t *testing.T) {
+	if got := grpcerrors.ReasonCode("required_if"); got != "REQUIRED_IF" {
+		t.Errorf("ReasonCode() = %q, want %q", got, "REQUIRED_IF")
+	}
+}
@@ -0,0 +1,148 @@
+package errors
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+)
+
+// RedactedValue is logged in place of a failed field's value by DefaultLogRedactor.
+const RedactedValue = "[REDACTED]"
+
+// DefaultMaxLoggedErrors is the default number of entries logged by ValidationErrors.
+const DefaultMaxLoggedErrors = 10
+
+var (
+	_ slog.LogValuer = ValidationError{}
+	_ slog.LogValuer = ValidationErrors{}
+	_ slog.LogValuer = logValuer{}
+)
+
+// LogRedactor returns the value logged for a failed field.
+type LogRedactor func(ValidationError) slog.Value
+
+// LogOption configures how validation errors are logged, see LogValue.
+type LogOption func(*logOptions)
+
+// logOptions holds the configuration of the logged validation errors.
+type logOptions struct {
+	maxLoggedErrors int
+	redactor        LogRedactor
+}
+
+// WithMaxLoggedErrors caps the number of entries emitted when the errors are logged.
+// A value of zero or less logs every entry. It defaults to DefaultMaxLoggedErrors.
+func WithMaxLoggedErrors(n int) LogOption {
+	return func(o *logOptions) {
+		o.maxLoggedErrors = n
+	}
+}
+
+// WithLogRedactor sets the function returning the value logged for a failed field.
+// Validated values frequently carry personal data, so it defaults to DefaultLogRedactor;
+// use it to reveal or mask values for fields known to be safe.
+func WithLogRedactor(r LogRedactor) LogOption {
+	return func(o *logOptions) {
+		o.redactor = r
+	}
+}
+
+// newLogOptions applies opts over the default configuration.
+func newLogOptions(opts []LogOption) logOptions {
+	o := logOptions{
+		maxLoggedErrors: DefaultMaxLoggedErrors,
+		redactor:        DefaultLogRedactor,
+	}
+
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.redactor == nil {
+		o.redactor = DefaultLogRedactor
+	}
+
+	return o
+}
+
+// logValuer logs validation errors with a given configuration.
+type logValuer struct {
+	errs ValidationErrors
+	opts logOptions
+}
+
+// LogValue returns a slog.LogValuer logging errs like ValidationErrors.LogValue, configured
+// with opts, e.g., to log more entries or to reveal the values of fields known to be safe:
+//
+//	slog.Warn("request rejected", "errors", errors.LogValue(errs, errors.WithMaxLoggedErrors(50)))
+func LogValue(errs ValidationErrors, opts ...LogOption) slog.LogValuer {
+	return logValuer{errs: errs, opts: newLogOptions(opts)}
+}
+
+// LogValue implements slog.LogValuer.
+func (l logValuer) LogValue() slog.Value {
+	return l.errs.logValue(l.opts)
+}
+
+// DefaultLogRedactor hides the value and keeps only its Go type, which is usually
+// enough to tell an empty value from a malformed one without leaking its content.
+func DefaultLogRedactor(e ValidationError) slog.Value {
+	if e.Value == nil {
+		return slog.StringValue(RedactedValue)
+	}
+
+	return slog.StringValue(fmt.Sprintf("%s (%T)", RedactedValue, e.Value))
+}
+
+// LogValue implements slog.LogValuer. It emits the path, type, param and value of the error
+// as a group, omitting param for rules without arguments. The value is redacted with
+// DefaultLogRedactor.
+func (e ValidationError) LogValue() slog.Value {
+	return e.logValue(DefaultLogRedactor)
+}
+
+// logValue returns the group logged for the error, with the value returned by redactor.
+func (e ValidationError) logValue(redactor LogRedactor) slog.Value {
+	attrs := make([]slog.Attr, 0, 4)
+	attrs = append(attrs,
+		slog.String("path", e.Path),
+		slog.String("type", e.Type),
+	)
+
+	if e.Param != "" {
+		attrs = append(attrs, slog.String("param", e.Param))
+	}
+
+	attrs = append(attrs, slog.Attr{Key: "value", Value: redactor(e)})
+
+	return slog.GroupValue(attrs...)
+}
+
+// LogValue implements slog.LogValuer. It emits the number of errors under "count" and
+// at most DefaultMaxLoggedErrors redacted entries keyed by their position; when entries
+// are dropped, their number is reported under "omitted". Use the LogValue function to
+// configure the logged entries.
+func (e ValidationErrors) LogValue() slog.Value {
+	return e.logValue(newLogOptions(nil))
+}
+
+// logValue returns the group logged for the errors with the configuration o.
+func (e ValidationErrors) logValue(o logOptions) slog.Value {
+	logged := len(e)
+	if o.maxLoggedErrors > 0 && logged > o.maxLoggedErrors {
+		logged = o.maxLoggedErrors
+	}
+
+	attrs := make([]slog.Attr, 0, logged+2)
+	attrs = append(attrs, slog.Int("count", len(e)))
+
+	for i := range logged {
+		attrs = append(attrs, slog.Attr{Key: strconv.Itoa(i), Value: e[i].logValue(o.redactor)})
+	}
+
+	if omitted := len(e) - logged; omitted > 0 {
+		attrs = append(attrs, slog.Int("omitted", omitted))
+	}
+
+	return slog.GroupValue(attrs...)
+}
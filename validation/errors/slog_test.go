@@ -0,0 +1,114 @@
+package errors_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func logJSON(t *testing.T, value any) map[string]any {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+	logger.Info("rejected", "errors", value)
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("failed to decode log record %q: %v", buf.String(), err)
+	}
+
+	group, ok := record["errors"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected errors to be logged as a group, got %#v", record["errors"])
+	}
+
+	return group
+}
+
+func TestValidationErrorLogValue(t *testing.T) {
+	err := govaliderrors.ValidationError{
+		Path:   "User.Name",
+		Type:   "maxlength",
+		Param:  "5",
+		Value:  "secret name",
+		Reason: "field Name must have a maximum length of 5",
+	}
+
+	got := logJSON(t, err)
+
+	want := map[string]any{
+		"path":  "User.Name",
+		"type":  "maxlength",
+		"param": "5",
+		"value": govaliderrors.RedactedValue + " (string)",
+	}
+
+	for key, value := range want {
+		if got[key] != value {
+			t.Errorf("%s = %#v, want %#v", key, got[key], value)
+		}
+	}
+
+	err.Param = ""
+	if _, ok := logJSON(t, err)["param"]; ok {
+		t.Error("expected param to be omitted for rules without arguments")
+	}
+}
+
+func TestValidationErrorsLogValue(t *testing.T) {
+	errs := make(govaliderrors.ValidationErrors, 0, govaliderrors.DefaultMaxLoggedErrors+3)
+	for range cap(errs) {
+		errs = append(errs, govaliderrors.ValidationError{Path: "User.Name", Type: "required"})
+	}
+
+	got := logJSON(t, errs)
+
+	if got["count"] != float64(len(errs)) {
+		t.Errorf("count = %v, want %d", got["count"], len(errs))
+	}
+
+	if got["omitted"] != float64(3) {
+		t.Errorf("omitted = %v, want 3", got["omitted"])
+	}
+
+	if _, ok := got["9"]; !ok {
+		t.Error("expected entry 9 to be logged")
+	}
+
+	if _, ok := got["10"]; ok {
+		t.Error("expected entry 10 to be dropped")
+	}
+}
+
+func TestLogValueOptions(t *testing.T) {
+	errs := govaliderrors.ValidationErrors{
+		{Path: "User.Name", Type: "required", Value: ""},
+		{Path: "User.Age", Type: "min", Param: "18", Value: 12},
+		{Path: "User.Email", Type: "email", Value: "john"},
+	}
+
+	got := logJSON(t, govaliderrors.LogValue(errs,
+		govaliderrors.WithMaxLoggedErrors(2),
+		govaliderrors.WithLogRedactor(func(e govaliderrors.ValidationError) slog.Value {
+			return slog.AnyValue(e.Value)
+		}),
+	))
+
+	if got["omitted"] != float64(1) {
+		t.Errorf("omitted = %v, want 1", got["omitted"])
+	}
+
+	entry, ok := got["1"].(map[string]any)
+	if !ok || entry["value"] != float64(12) {
+		t.Errorf("expected the value of entry 1 to be revealed, got %#v", got["1"])
+	}
+
+	if _, ok := logJSON(t, errs)["omitted"]; ok {
+		t.Error("expected the options not to affect the default logging")
+	}
+}
@@ -0,0 +1,137 @@
+package errors
+
+import (
+	"strings"
+)
+
+// TreeErrorsKey is the key under which AsMap stores the errors reported for a node itself,
+// keeping them apart from the keys of nested fields.
+const TreeErrorsKey = "_errors"
+
+// ErrorDetail is the description of a single validation failure stored in an ErrorTree.
+type ErrorDetail struct {
+	// Type is the type of validation that failed, e.g., "required", "email".
+	Type string `json:"type"`
+	// Reason is a human-readable message explaining why the validation failed.
+	Reason string `json:"reason"`
+}
+
+// ErrorTree is a node of the nested representation of ValidationErrors.
+// A node carries the errors reported for its own path and the nodes of its nested fields,
+// keyed by path segment. Collection indices become segments of their own, so "Items[0].Name"
+// is reachable through Fields["Items"].Fields["0"].Fields["Name"].
+type ErrorTree struct {
+	// Errors are the validation failures reported for this node's path.
+	Errors []ErrorDetail `json:"errors,omitempty"`
+	// Fields are the nested nodes keyed by path segment.
+	Fields map[string]*ErrorTree `json:"fields,omitempty"`
+}
+
+// Tree arranges the errors into an ErrorTree keyed by the segments of their Path.
+// The root node itself carries only errors whose Path is empty.
+func (e ValidationErrors) Tree() *ErrorTree {
+	root := &ErrorTree{}
+
+	for _, err := range e {
+		node := root
+		for _, segment := range PathSegments(err.Path) {
+			node = node.child(segment)
+		}
+
+		node.Errors = append(node.Errors, ErrorDetail{Type: err.Type, Reason: err.Reason})
+	}
+
+	return root
+}
+
+// AsMap returns the errors as nested maps keyed by path segment, ready to be encoded as JSON
+// for form UIs. The errors of a node are listed under TreeErrorsKey, each as a map with "type"
+// and "reason" keys. The result only contains map[string]any, []any and string values, so it
+// survives a JSON round-trip unchanged.
+func (e ValidationErrors) AsMap() map[string]any {
+	return e.Tree().AsMap()
+}
+
+// AsMap converts the tree into nested maps as described in ValidationErrors.AsMap.
+func (t *ErrorTree) AsMap() map[string]any {
+	m := make(map[string]any, len(t.Fields)+1)
+
+	if len(t.Errors) > 0 {
+		details := make([]any, 0, len(t.Errors))
+		for _, d := range t.Errors {
+			details = append(details, map[string]any{"type": d.Type, "reason": d.Reason})
+		}
+
+		m[TreeErrorsKey] = details
+	}
+
+	for segment, child := range t.Fields {
+		m[segment] = child.AsMap()
+	}
+
+	return m
+}
+
+// Lookup returns the node at the given path, e.g., "User.Addresses[0].City", or nil if no
+// errors were reported at or below it.
+func (t *ErrorTree) Lookup(path string) *ErrorTree {
+	node := t
+	for _, segment := range PathSegments(path) {
+		if node == nil {
+			return nil
+		}
+
+		node = node.Fields[segment]
+	}
+
+	return node
+}
+
+// child returns the child node for segment, creating it if it does not exist yet.
+func (t *ErrorTree) child(segment string) *ErrorTree {
+	if t.Fields == nil {
+		t.Fields = make(map[string]*ErrorTree)
+	}
+
+	node, ok := t.Fields[segment]
+	if !ok {
+		node = &ErrorTree{}
+		t.Fields[segment] = node
+	}
+
+	return node
+}
+
+// PathSegments splits a validation error path into its segments.
+// Dots separate fields and every bracketed index becomes a segment of its own,
+// e.g., "User.Addresses[0].City" yields ["User", "Addresses", "0", "City"].
+func PathSegments(path string) []string {
+	segments := make([]string, 0, strings.Count(path, ".")+strings.Count(path, "[")+1)
+
+	for _, part := range strings.Split(path, ".") {
+		for part != "" {
+			open := strings.IndexByte(part, '[')
+			if open == -1 {
+				segments = append(segments, part)
+
+				break
+			}
+
+			if open > 0 {
+				segments = append(segments, part[:open])
+			}
+
+			end := strings.IndexByte(part[open:], ']')
+			if end == -1 {
+				segments = append(segments, part[open+1:])
+
+				break
+			}
+
+			segments = append(segments, part[open+1:open+end])
+			part = part[open+end+1:]
+		}
+	}
+
+	return segments
+}
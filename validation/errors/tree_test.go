@@ -0,0 +1,119 @@
+package errors_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+func TestPathSegments(t *testing.T) {
+	tests := map[string]struct {
+		path string
+		want []string
+	}{
+		"empty":          {path: "", want: []string{}},
+		"single":         {path: "Name", want: []string{"Name"}},
+		"nested":         {path: "User.Address.City", want: []string{"User", "Address", "City"}},
+		"index":          {path: "User.Addresses[0].City", want: []string{"User", "Addresses", "0", "City"}},
+		"multiple index": {path: "Matrix[1][2]", want: []string{"Matrix", "1", "2"}},
+		"map key":        {path: "Labels[env]", want: []string{"Labels", "env"}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := govaliderrors.PathSegments(tt.path)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("PathSegments(%q) = %#v, want %#v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidationErrorsTree(t *testing.T) {
+	errs := govaliderrors.ValidationErrors{
+		{Path: "Person.Name", Type: "required", Reason: "field Name is required"},
+		{Path: "Person.Addresses[0].City", Type: "required", Reason: "field City is required"},
+		{Path: "Person.Addresses[0].ZipCode", Type: "minlength", Reason: "field ZipCode must have a minimum length of 5"},
+		{Path: "Person.Addresses[0].ZipCode", Type: "numeric", Reason: "field ZipCode must be numeric"},
+		{Path: "Person.Addresses", Type: "maxitems", Reason: "field Addresses must have a maximum of 2 items"},
+	}
+
+	tree := errs.Tree()
+
+	zip := tree.Lookup("Person.Addresses[0].ZipCode")
+	if zip == nil || len(zip.Errors) != 2 {
+		t.Fatalf("expected two errors for ZipCode, got %+v", zip)
+	}
+
+	addresses := tree.Lookup("Person.Addresses")
+	if addresses == nil || len(addresses.Errors) != 1 || len(addresses.Fields) != 1 {
+		t.Fatalf("expected Addresses to carry its own error and one indexed child, got %+v", addresses)
+	}
+
+	if got := tree.Lookup("Person.Email"); got != nil {
+		t.Errorf("expected no node for Person.Email, got %+v", got)
+	}
+
+	want := map[string]any{
+		"Person": map[string]any{
+			"Name": map[string]any{
+				govaliderrors.TreeErrorsKey: []any{
+					map[string]any{"type": "required", "reason": "field Name is required"},
+				},
+			},
+			"Addresses": map[string]any{
+				govaliderrors.TreeErrorsKey: []any{
+					map[string]any{"type": "maxitems", "reason": "field Addresses must have a maximum of 2 items"},
+				},
+				"0": map[string]any{
+					"City": map[string]any{
+						govaliderrors.TreeErrorsKey: []any{
+							map[string]any{"type": "required", "reason": "field City is required"},
+						},
+					},
+					"ZipCode": map[string]any{
+						govaliderrors.TreeErrorsKey: []any{
+							map[string]any{"type": "minlength", "reason": "field ZipCode must have a minimum length of 5"},
+							map[string]any{"type": "numeric", "reason": "field ZipCode must be numeric"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	got := errs.AsMap()
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("AsMap() = %#v, want %#v", got, want)
+	}
+
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("failed to marshal map: %v", err)
+	}
+
+	var roundTrip map[string]any
+	if err := json.Unmarshal(data, &roundTrip); err != nil {
+		t.Fatalf("failed to unmarshal map: %v", err)
+	}
+
+	if !reflect.DeepEqual(roundTrip, want) {
+		t.Errorf("AsMap() did not survive a JSON round-trip: %#v", roundTrip)
+	}
+
+	data, err = json.Marshal(tree)
+	if err != nil {
+		t.Fatalf("failed to marshal tree: %v", err)
+	}
+
+	var treeRoundTrip govaliderrors.ErrorTree
+	if err := json.Unmarshal(data, &treeRoundTrip); err != nil {
+		t.Fatalf("failed to unmarshal tree: %v", err)
+	}
+
+	if !reflect.DeepEqual(&treeRoundTrip, tree) {
+		t.Errorf("Tree() did not survive a JSON round-trip: %+v", treeRoundTrip)
+	}
+}
@@ -0,0 +1,90 @@
+// Package fieldmask selects the fields checked by the generated Validate{{Type}}Fields functions,
+// which validate partial updates such as PATCH requests.
+package fieldmask
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// Covers reports whether any of the paths, or one of their ancestors, is in mask.
+// Paths are dot-separated, e.g., "Address.City", and name the Go fields or their JSON keys;
+// the indexes of slices are omitted, so "Items.Name" stands for the Name of every item.
+// A path covers itself and every field nested under it, so "Address" covers "Address.City".
+func Covers(mask []string, paths ...string) bool {
+	for _, path := range paths {
+		for {
+			if slices.Contains(mask, path) {
+				return true
+			}
+
+			i := strings.LastIndexByte(path, '.')
+			if i < 0 {
+				break
+			}
+
+			path = path[:i]
+		}
+	}
+
+	return false
+}
+
+// FromMergePatch returns the paths of the members set by a JSON Merge Patch document (RFC 7396),
+// sorted, using the keys of the document. Nested objects contribute the paths of their members,
+// while null members, which remove the field, and arrays, which replace it, are leaves:
+//
+//	{"name": "Gopher", "address": {"city": "Paris"}, "phone": null}
+//
+// yields "address.city", "name" and "phone". Validate the result of applying the patch with these
+// paths, so that rules of untouched fields, such as required ones, do not reject the update.
+func FromMergePatch(patch []byte) ([]string, error) {
+	var doc map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &doc); err != nil {
+		return nil, fmt.Errorf("merge patch must be a JSON object: %w", err)
+	}
+
+	var paths []string
+	if err := collectPaths(doc, "", &paths); err != nil {
+		return nil, err
+	}
+
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// collectPaths appends the paths of the members of doc under prefix to paths.
+func collectPaths(doc map[string]json.RawMessage, prefix string, paths *[]string) error {
+	for key, value := range doc {
+		path := prefix + key
+
+		value = bytes.TrimSpace(value)
+		if len(value) == 0 || value[0] != '{' {
+			*paths = append(*paths, path)
+
+			continue
+		}
+
+		var nested map[string]json.RawMessage
+		if err := json.Unmarshal(value, &nested); err != nil {
+			return fmt.Errorf("invalid member %q: %w", path, err)
+		}
+
+		if len(nested) == 0 {
+			*paths = append(*paths, path)
+
+			continue
+		}
+
+		if err := collectPaths(nested, path+".", paths); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package fieldmask_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/templatedop/govalid/validation/fieldmask"
+)
+
+func TestCovers(t *testing.T) {
+	mask := []string{"name", "Address"}
+
+	tests := []struct {
+		paths    []string
+		expected bool
+	}{
+		{[]string{"name"}, true},
+		{[]string{"Name"}, false},
+		{[]string{"Address.City"}, true},
+		{[]string{"Addresses"}, false},
+		{[]string{"Email", "email", "name"}, true},
+		{nil, false},
+	}
+
+	for _, tt := range tests {
+		if got := fieldmask.Covers(mask, tt.paths...); got != tt.expected {
+			t.Errorf("Covers(%v) = %v, want %v", tt.paths, got, tt.expected)
+		}
+	}
+}
+
+func TestFromMergePatch(t *testing.T) {
+	patch := []byte(`{"name": "Gopher", "address": {"city": "Paris", "geo": {"lat": 1}}, "phone": null, "tags": ["a"], "meta": {}}`)
+
+	got, err := fieldmask.FromMergePatch(patch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"address.city", "address.geo.lat", "meta", "name", "phone", "tags"}
+	if !slices.Equal(got, want) {
+		t.Errorf("FromMergePatch() = %v, want %v", got, want)
+	}
+
+	if _, err := fieldmask.FromMergePatch([]byte(`["name"]`)); err == nil {
+		t.Error("expected an error for a patch that is not an object")
+	}
+}
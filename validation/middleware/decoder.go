@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+)
+
+// DefaultMultipartMaxMemory is the default MultipartFormDecoder.MaxMemory, matching the one
+// used by http.Request.FormFile.
+const DefaultMultipartMaxMemory = 32 << 20
+
+// Decoder decodes the body of a request into v, which is a pointer to the value being decoded.
+type Decoder interface {
+	Decode(r *http.Request, v any) error
+}
+
+// DecoderFunc is an adapter to allow the use of ordinary functions as a Decoder.
+type DecoderFunc func(r *http.Request, v any) error
+
+// Decode calls f(r, v).
+func (f DecoderFunc) Decode(r *http.Request, v any) error {
+	return f(r, v)
+}
+
+// ValuesDecoder is implemented by types that can populate themselves from url.Values,
+// which is how FormDecoder fills the target without reflection.
+type ValuesDecoder interface {
+	DecodeValues(values url.Values) error
+}
+
+// MultipartDecoder is implemented by types that can populate themselves from a parsed multipart form,
+// which is how MultipartFormDecoder fills the target without reflection. govalid generates it for
+// structs with *multipart.FileHeader or []*multipart.FileHeader fields tagged with form.
+type MultipartDecoder interface {
+	DecodeMultipart(form *multipart.Form) error
+}
+
+// JSONDecoder decodes JSON request bodies with encoding/json.
+type JSONDecoder struct {
+	// DisallowUnknownFields rejects objects with keys that do not match any field of the target.
+	DisallowUnknownFields bool
+}
+
+// Decode implements Decoder.
+func (d JSONDecoder) Decode(r *http.Request, v any) error {
+	decoder := json.NewDecoder(r.Body)
+	if d.DisallowUnknownFields {
+		decoder.DisallowUnknownFields()
+	}
+
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("failed to decode JSON: %w", err)
+	}
+
+	return nil
+}
+
+// XMLDecoder decodes XML request bodies with encoding/xml.
+type XMLDecoder struct{}
+
+// Decode implements Decoder.
+func (XMLDecoder) Decode(r *http.Request, v any) error {
+	if err := xml.NewDecoder(r.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode XML: %w", err)
+	}
+
+	return nil
+}
+
+// FormDecoder decodes application/x-www-form-urlencoded request bodies.
+// The target must implement ValuesDecoder; it receives the parsed body parameters.
+type FormDecoder struct{}
+
+// Decode implements Decoder.
+func (FormDecoder) Decode(r *http.Request, v any) error {
+	target, ok := v.(ValuesDecoder)
+	if !ok {
+		return fmt.Errorf("%w: %T does not implement ValuesDecoder", ErrUnsupportedMediaType, v)
+	}
+
+	if err := r.ParseForm(); err != nil {
+		return fmt.Errorf("failed to parse form: %w", err)
+	}
+
+	if err := target.DecodeValues(r.PostForm); err != nil {
+		return fmt.Errorf("failed to decode form: %w", err)
+	}
+
+	return nil
+}
+
+// MultipartFormDecoder decodes multipart/form-data request bodies.
+// The target must implement MultipartDecoder; it receives the parsed form values and files.
+type MultipartFormDecoder struct {
+	// MaxMemory is the number of bytes of the files kept in memory, the rest being stored in
+	// temporary files. It defaults to DefaultMultipartMaxMemory; use WithMaxBodyBytes to limit
+	// the size of the whole body.
+	MaxMemory int64
+}
+
+// Decode implements Decoder.
+func (d MultipartFormDecoder) Decode(r *http.Request, v any) error {
+	target, ok := v.(MultipartDecoder)
+	if !ok {
+		return fmt.Errorf("%w: %T does not implement MultipartDecoder", ErrUnsupportedMediaType, v)
+	}
+
+	maxMemory := d.MaxMemory
+	if maxMemory <= 0 {
+		maxMemory = DefaultMultipartMaxMemory
+	}
+
+	if err := r.ParseMultipartForm(maxMemory); err != nil {
+		return fmt.Errorf("failed to parse multipart form: %w", err)
+	}
+
+	if err := target.DecodeMultipart(r.MultipartForm); err != nil {
+		return fmt.Errorf("failed to decode multipart form: %w", err)
+	}
+
+	return nil
+}
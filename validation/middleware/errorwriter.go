@@ -0,0 +1,85 @@
+package middleware
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+// ErrorWriter writes the response for a request rejected by the middleware with the given status.
+// err is either a decoding error (see ErrInvalidBody, ErrBodyTooLarge and ErrUnsupportedMediaType)
+// or the error returned by the generated validator.
+type ErrorWriter func(w http.ResponseWriter, r *http.Request, status int, err error)
+
+// ErrorResponse is the body written by JSONErrorWriter.
+type ErrorResponse struct {
+	// Message is a short description of why the request was rejected.
+	Message string `json:"message"`
+	// Errors lists the individual validation failures, if any.
+	Errors []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError describes a single validation failure in an ErrorResponse.
+type FieldError struct {
+	Path   string `json:"path"`
+	Type   string `json:"type"`
+	Param  string `json:"param,omitempty"`
+	Reason string `json:"reason"`
+}
+
+// PlainTextErrorWriter writes err as a plain text response. Validation errors are prefixed
+// with "Validation error: ", other errors are reported by their message.
+func PlainTextErrorWriter(w http.ResponseWriter, _ *http.Request, status int, err error) {
+	if isValidationError(err) {
+		http.Error(w, "Validation error: "+err.Error(), status)
+
+		return
+	}
+
+	http.Error(w, err.Error(), status)
+}
+
+// JSONErrorWriter writes err as an ErrorResponse encoded in JSON, listing every validation failure
+// with its path, type, param and reason.
+func JSONErrorWriter(w http.ResponseWriter, _ *http.Request, status int, err error) {
+	resp := ErrorResponse{Message: err.Error()}
+
+	var verrs govaliderrors.ValidationErrors
+
+	var verr govaliderrors.ValidationError
+
+	switch {
+	case errors.As(err, &verrs):
+		resp.Message = "validation failed"
+		resp.Errors = make([]FieldError, 0, len(verrs))
+
+		for _, e := range verrs {
+			resp.Errors = append(resp.Errors, newFieldError(e))
+		}
+	case errors.As(err, &verr):
+		resp.Message = "validation failed"
+		resp.Errors = []FieldError{newFieldError(verr)}
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+
+	_ = json.NewEncoder(w).Encode(resp)
+}
+
+// newFieldError converts a ValidationError into a FieldError.
+func newFieldError(e govaliderrors.ValidationError) FieldError {
+	return FieldError{Path: e.Path, Type: e.Type, Param: e.Param, Reason: e.Reason}
+}
+
+// isValidationError reports whether err is, or wraps, a ValidationErrors or a ValidationError.
+func isValidationError(err error) bool {
+	var verrs govaliderrors.ValidationErrors
+
+	var verr govaliderrors.ValidationError
+
+	return errors.As(err, &verrs) || errors.As(err, &verr)
+}
@@ -2,29 +2,250 @@
 package middleware
 
 import (
-	"encoding/json"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
 	"net/http"
+	"reflect"
 
 	"github.com/templatedop/govalid"
 )
 
+var (
+	// ErrInvalidBody is returned when the request body cannot be decoded.
+	ErrInvalidBody = errors.New("invalid request body")
+
+	// ErrBodyTooLarge is returned when the request body exceeds the limit set with WithMaxBodyBytes.
+	ErrBodyTooLarge = errors.New("request body too large")
+
+	// ErrUnsupportedMediaType is returned when no decoder is registered for the request Content-Type.
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+)
+
+// contextKey is the key under which the validated request body is stored in the request context.
+type contextKey struct{}
+
+// Option configures the validation middleware.
+type Option func(*options)
+
+// options holds the configuration of the validation middleware.
+type options struct {
+	preserveBody          bool
+	disallowUnknownFields bool
+	maxBodyBytes          int64
+	status                int
+	decoders              map[string]Decoder
+	errorWriter           ErrorWriter
+}
+
+// PreserveBody makes the middleware restore r.Body after decoding, so that the next handler
+// can read the raw payload again, e.g., to verify a signature over it.
+func PreserveBody() Option {
+	return func(o *options) {
+		o.preserveBody = true
+	}
+}
+
+// DisallowUnknownFields makes the default JSON decoder reject payloads with fields
+// that do not exist in the target type.
+func DisallowUnknownFields() Option {
+	return func(o *options) {
+		o.disallowUnknownFields = true
+	}
+}
+
+// WithMaxBodyBytes limits the size of the request body with http.MaxBytesReader.
+// Larger bodies are rejected with 413 Request Entity Too Large.
+func WithMaxBodyBytes(n int64) Option {
+	return func(o *options) {
+		o.maxBodyBytes = n
+	}
+}
+
+// WithStatus sets the status code used when validation fails, e.g., http.StatusUnprocessableEntity.
+// It defaults to http.StatusBadRequest.
+func WithStatus(status int) Option {
+	return func(o *options) {
+		o.status = status
+	}
+}
+
+// WithDecoder registers the decoder used for requests with the given media type, e.g., "application/json",
+// replacing the default one if any.
+func WithDecoder(mediaType string, d Decoder) Option {
+	return func(o *options) {
+		o.decoders[mediaType] = d
+	}
+}
+
+// WithErrorWriter sets the function used to write error responses. It defaults to PlainTextErrorWriter.
+func WithErrorWriter(ew ErrorWriter) Option {
+	return func(o *options) {
+		o.errorWriter = ew
+	}
+}
+
+// newOptions applies opts over the default configuration.
+func newOptions(opts []Option) *options {
+	o := &options{
+		status:      http.StatusBadRequest,
+		decoders:    map[string]Decoder{},
+		errorWriter: PlainTextErrorWriter,
+	}
+
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	defaults := map[string]Decoder{
+		"application/json":                  JSONDecoder{DisallowUnknownFields: o.disallowUnknownFields},
+		"application/xml":                   XMLDecoder{},
+		"text/xml":                          XMLDecoder{},
+		"application/x-www-form-urlencoded": FormDecoder{},
+		"multipart/form-data":               MultipartFormDecoder{},
+	}
+
+	for mediaType, d := range defaults {
+		if _, ok := o.decoders[mediaType]; !ok {
+			o.decoders[mediaType] = d
+		}
+	}
+
+	return o
+}
+
 // ValidateRequest returns a middleware that validates the request body using the provided Validator type.
-// If validation fails, it responds with a 400 Bad Request.
-func ValidateRequest[T govalid.Validator](next http.HandlerFunc) http.HandlerFunc {
+// If validation fails, it responds with a 400 Bad Request, or the status set with WithStatus.
+// The decoded value is stored in the request context and can be retrieved with FromContext.
+func ValidateRequest[T govalid.Validator](next http.HandlerFunc, opts ...Option) http.HandlerFunc {
+	return Handle(func(w http.ResponseWriter, r *http.Request, _ T) {
+		next(w, r)
+	}, opts...)
+}
+
+// Handle returns a handler that decodes and validates the request body as T and passes
+// the validated value to next. The decoder is selected by the request Content-Type, defaulting
+// to JSON when it is missing. If decoding or validation fails, the error is written with the
+// configured ErrorWriter and next is not called. The value is also stored in the request context,
+// see FromContext.
+func Handle[T govalid.Validator](next func(http.ResponseWriter, *http.Request, T), opts ...Option) http.HandlerFunc {
+	o := newOptions(opts)
+
 	return func(w http.ResponseWriter, r *http.Request) {
-		var body T
-		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-			http.Error(w, "Invalid JSON", http.StatusBadRequest)
+		body, err := decode[T](w, r, o)
+		if err != nil {
+			status := decodeStatus(err)
+			if isValidationError(err) {
+				// Types with a validating UnmarshalJSON, see +govalid:unmarshal, fail while decoding.
+				status = o.status
+			}
+
+			o.errorWriter(w, r, status, err)
 
 			return
 		}
 
 		if err := body.Validate(); err != nil {
-			http.Error(w, "Validation error: "+err.Error(), http.StatusBadRequest)
+			o.errorWriter(w, r, o.status, err)
 
 			return
 		}
 
-		next(w, r)
+		r = r.WithContext(context.WithValue(r.Context(), contextKey{}, body))
+
+		next(w, r, body)
+	}
+}
+
+// FromContext returns the validated request body stored by ValidateRequest or Handle.
+// It reports false if no body of type T was stored in ctx.
+func FromContext[T any](ctx context.Context) (T, bool) {
+	body, ok := ctx.Value(contextKey{}).(T)
+
+	return body, ok
+}
+
+// decode decodes the request body into a new T with the decoder registered for its Content-Type,
+// restoring r.Body afterwards if requested.
+func decode[T any](w http.ResponseWriter, r *http.Request, o *options) (T, error) {
+	body, target := newBody[T]()
+
+	mediaType := "application/json"
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		parsed, _, err := mime.ParseMediaType(ct)
+		if err != nil {
+			return body, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, ct)
+		}
+
+		mediaType = parsed
+	}
+
+	decoder, ok := o.decoders[mediaType]
+	if !ok {
+		return body, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, mediaType)
+	}
+
+	if o.maxBodyBytes > 0 {
+		r.Body = http.MaxBytesReader(w, r.Body, o.maxBodyBytes)
+	}
+
+	if o.preserveBody {
+		raw, err := io.ReadAll(r.Body)
+		if err != nil {
+			return body, bodyError(err)
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(raw))
+		defer func() { r.Body = io.NopCloser(bytes.NewReader(raw)) }()
+	}
+
+	if err := decoder.Decode(r, target); err != nil {
+		return body, bodyError(err)
+	}
+
+	return body, nil
+}
+
+// newBody returns a new T together with the decoding target for it. Validators are usually
+// pointer types, so for a pointer T the pointed-to value is allocated and used as the target;
+// otherwise the target is a pointer to the returned value.
+func newBody[T any]() (T, any) {
+	var body T
+
+	if typ := reflect.TypeFor[T](); typ.Kind() == reflect.Pointer {
+		body, _ = reflect.New(typ.Elem()).Interface().(T)
+
+		return body, body
+	}
+
+	return body, &body
+}
+
+// bodyError classifies an error returned while reading or decoding the request body.
+func bodyError(err error) error {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return fmt.Errorf("%w: limit is %d bytes", ErrBodyTooLarge, maxBytesErr.Limit)
+	}
+
+	if errors.Is(err, ErrUnsupportedMediaType) || isValidationError(err) {
+		return err
+	}
+
+	return fmt.Errorf("%w: %w", ErrInvalidBody, err)
+}
+
+// decodeStatus returns the response status for an error returned by decode.
+func decodeStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrBodyTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupportedMediaType):
+		return http.StatusUnsupportedMediaType
+	default:
+		return http.StatusBadRequest
 	}
 }
@@ -5,6 +5,8 @@ package middleware_test
 import (
 	"bytes"
 	"encoding/json"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -62,3 +64,414 @@ func TestValidateRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestHandle(t *testing.T) {
+	t.Parallel()
+
+	var got *testfixture.PersonRequest
+
+	sut := middleware.Handle(func(w http.ResponseWriter, r *http.Request, body *testfixture.PersonRequest) {
+		got = body
+
+		fromCtx, ok := middleware.FromContext[*testfixture.PersonRequest](r.Context())
+		if !ok || fromCtx != body {
+			t.Errorf("expected the validated body in the request context, got %v (ok=%v)", fromCtx, ok)
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"name":"John","email":"john@example.com"}`))
+	rr := httptest.NewRecorder()
+	sut(rr, req)
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+
+	if got == nil || got.Name != "John" || got.Email != "john@example.com" {
+		t.Errorf("Expected decoded body to be passed to the handler, got %+v", got)
+	}
+}
+
+func TestPreserveBody(t *testing.T) {
+	t.Parallel()
+
+	const payload = `{"name":"John","email":"john@example.com"}`
+
+	tests := map[string]struct {
+		opts []middleware.Option
+		want string
+	}{
+		"body consumed by default": {
+			want: "",
+		},
+		"body preserved": {
+			opts: []middleware.Option{middleware.PreserveBody()},
+			want: payload,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var raw []byte
+
+			testHandler := func(w http.ResponseWriter, r *http.Request) {
+				raw, _ = io.ReadAll(r.Body)
+
+				if _, ok := middleware.FromContext[*testfixture.PersonRequest](r.Context()); !ok {
+					t.Error("Expected the validated body in the request context")
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}
+
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(payload))
+			rr := httptest.NewRecorder()
+			sut := middleware.ValidateRequest[*testfixture.PersonRequest](testHandler, tt.opts...)
+			sut(rr, req)
+
+			if string(raw) != tt.want {
+				t.Errorf("Expected raw body %q, got %q", tt.want, raw)
+			}
+		})
+	}
+}
+
+func TestValidateRequestOptions(t *testing.T) {
+	t.Parallel()
+
+	const valid = `{"name":"John","email":"john@example.com"}`
+
+	tests := map[string]struct {
+		contentType string
+		body        string
+		opts        []middleware.Option
+		want        int
+	}{
+		"missing content type defaults to JSON": {
+			body: valid,
+			want: http.StatusOK,
+		},
+		"content type with parameters": {
+			contentType: "application/json; charset=utf-8",
+			body:        valid,
+			want:        http.StatusOK,
+		},
+		"xml": {
+			contentType: "application/xml",
+			body:        `<PersonRequest><Name>John</Name><Email>john@example.com</Email></PersonRequest>`,
+			want:        http.StatusOK,
+		},
+		"unsupported media type": {
+			contentType: "text/csv",
+			body:        "John,john@example.com",
+			want:        http.StatusUnsupportedMediaType,
+		},
+		"form without ValuesDecoder": {
+			contentType: "application/x-www-form-urlencoded",
+			body:        "name=John&email=john@example.com",
+			want:        http.StatusUnsupportedMediaType,
+		},
+		"unknown fields allowed by default": {
+			body: `{"name":"John","email":"john@example.com","age":42}`,
+			want: http.StatusOK,
+		},
+		"unknown fields disallowed": {
+			body: `{"name":"John","email":"john@example.com","age":42}`,
+			opts: []middleware.Option{middleware.DisallowUnknownFields()},
+			want: http.StatusBadRequest,
+		},
+		"body within limit": {
+			body: valid,
+			opts: []middleware.Option{middleware.WithMaxBodyBytes(int64(len(valid)))},
+			want: http.StatusOK,
+		},
+		"body too large": {
+			body: valid,
+			opts: []middleware.Option{middleware.WithMaxBodyBytes(10)},
+			want: http.StatusRequestEntityTooLarge,
+		},
+		"custom validation status": {
+			body: `{"name":"John","email":"invalid-email"}`,
+			opts: []middleware.Option{middleware.WithStatus(http.StatusUnprocessableEntity)},
+			want: http.StatusUnprocessableEntity,
+		},
+		"custom decoder": {
+			contentType: "text/plain",
+			body:        "John <john@example.com>",
+			opts: []middleware.Option{middleware.WithDecoder("text/plain", middleware.DecoderFunc(func(_ *http.Request, v any) error {
+				p, _ := v.(*testfixture.PersonRequest)
+				p.Name, p.Email = "John", "john@example.com"
+
+				return nil
+			}))},
+			want: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			testHandler := func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}
+
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tt.body))
+			if tt.contentType != "" {
+				req.Header.Set("Content-Type", tt.contentType)
+			}
+
+			rr := httptest.NewRecorder()
+			sut := middleware.ValidateRequest[*testfixture.PersonRequest](testHandler, tt.opts...)
+			sut(rr, req)
+
+			if rr.Code != tt.want {
+				t.Errorf("Expected status %d, got %d (%s)", tt.want, rr.Code, rr.Body.String())
+			}
+		})
+	}
+}
+
+func TestJSONErrorWriter(t *testing.T) {
+	t.Parallel()
+
+	testHandler := func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"name":"John","email":"invalid-email"}`))
+	rr := httptest.NewRecorder()
+	sut := middleware.ValidateRequest[*testfixture.PersonRequest](testHandler,
+		middleware.WithStatus(http.StatusUnprocessableEntity),
+		middleware.WithErrorWriter(middleware.JSONErrorWriter),
+	)
+	sut(rr, req)
+
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected status %d, got %d", http.StatusUnprocessableEntity, rr.Code)
+	}
+
+	if ct := rr.Header().Get("Content-Type"); ct != "application/json; charset=utf-8" {
+		t.Errorf("Expected JSON content type, got %q", ct)
+	}
+
+	var resp middleware.ErrorResponse
+	if err := json.Unmarshal(rr.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode error response: %v", err)
+	}
+
+	want := middleware.FieldError{Path: "PersonRequest.Email", Type: "email", Reason: "field Email must be a valid email address"}
+	if len(resp.Errors) != 1 || resp.Errors[0] != want {
+		t.Errorf("Expected errors [%+v], got %+v", want, resp.Errors)
+	}
+}
+
+func TestHandleValues(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		target string
+		want   int
+	}{
+		"valid parameters":   {target: "/orgs/acme/search?limit=10&tag=a&tag=b", want: http.StatusOK},
+		"invalid conversion": {target: "/orgs/acme/search?limit=ten", want: http.StatusBadRequest},
+		"rule violation":     {target: "/orgs/acme/search?limit=500", want: http.StatusBadRequest},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *testfixture.SearchRequest
+
+			mux := http.NewServeMux()
+			mux.Handle("GET /orgs/{org}/search", middleware.HandleValues(func(w http.ResponseWriter, r *http.Request, body *testfixture.SearchRequest) {
+				got = body
+
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			rr := httptest.NewRecorder()
+			mux.ServeHTTP(rr, httptest.NewRequest("GET", tt.target, nil))
+
+			if rr.Code != tt.want {
+				t.Fatalf("Expected status %d, got %d: %s", tt.want, rr.Code, rr.Body.String())
+			}
+
+			if tt.want != http.StatusOK {
+				return
+			}
+
+			if got == nil || got.Org != "acme" || got.Limit != 10 || len(got.Tags) != 2 {
+				t.Errorf("Expected bound parameters to be passed to the handler, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestHandleValuesLeavesFormUnchanged(t *testing.T) {
+	t.Parallel()
+
+	var form map[string][]string
+
+	mux := http.NewServeMux()
+	mux.Handle("GET /orgs/{org}/search", middleware.HandleValues(func(w http.ResponseWriter, r *http.Request, _ *testfixture.SearchRequest) {
+		form = r.Form
+
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	rr := httptest.NewRecorder()
+	mux.ServeHTTP(rr, httptest.NewRequest("GET", "/orgs/acme/search?limit=10", nil))
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, rr.Code, rr.Body.String())
+	}
+
+	if _, ok := form["org"]; ok {
+		t.Errorf("Expected path parameters not to be added to r.Form, got %v", form)
+	}
+}
+
+func TestHandleValuesReportsAllErrors(t *testing.T) {
+	t.Parallel()
+
+	sut := middleware.ValidateValues[*testfixture.SearchRequest](func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}, middleware.WithErrorWriter(middleware.JSONErrorWriter))
+
+	rr := httptest.NewRecorder()
+	sut(rr, httptest.NewRequest("GET", "/search?limit=ten", nil))
+
+	var resp middleware.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	types := map[string]bool{}
+	for _, e := range resp.Errors {
+		types[e.Type] = true
+	}
+
+	if !types["conversion"] || !types["required"] {
+		t.Errorf("Expected conversion and required errors, got %+v", resp.Errors)
+	}
+}
+
+func TestHandleValidatingUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	sut := middleware.Handle(func(w http.ResponseWriter, _ *http.Request, _ *testfixture.SignupRequest) {
+		w.WriteHeader(http.StatusOK)
+	}, middleware.WithStatus(http.StatusUnprocessableEntity), middleware.WithErrorWriter(middleware.JSONErrorWriter))
+
+	rr := httptest.NewRecorder()
+	sut(rr, httptest.NewRequest("POST", "/signup", bytes.NewBufferString(`{"email":"invalid"}`)))
+
+	if rr.Code != http.StatusUnprocessableEntity {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusUnprocessableEntity, rr.Code, rr.Body.String())
+	}
+
+	var resp middleware.ErrorResponse
+	if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+
+	if len(resp.Errors) != 1 || resp.Errors[0].Type != "email" {
+		t.Errorf("Expected a single email error, got %+v", resp.Errors)
+	}
+}
+
+// pngHeader is the signature http.DetectContentType recognizes as image/png.
+var pngHeader = []byte("\x89PNG\r\n\x1a\n")
+
+func newMultipartRequest(t *testing.T, title string, files map[string][]byte) *http.Request {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	mw := multipart.NewWriter(&body)
+	if err := mw.WriteField("title", title); err != nil {
+		t.Fatalf("failed to write field: %v", err)
+	}
+
+	for name, content := range files {
+		fw, err := mw.CreateFormFile("image", name)
+		if err != nil {
+			t.Fatalf("failed to create form file: %v", err)
+		}
+
+		if _, err := fw.Write(content); err != nil {
+			t.Fatalf("failed to write form file: %v", err)
+		}
+	}
+
+	if err := mw.Close(); err != nil {
+		t.Fatalf("failed to close multipart writer: %v", err)
+	}
+
+	req := httptest.NewRequest("POST", "/upload", &body)
+	req.Header.Set("Content-Type", mw.FormDataContentType())
+
+	return req
+}
+
+func TestHandleMultipart(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		title    string
+		files    map[string][]byte
+		want     int
+		wantType string
+	}{
+		"valid upload":       {title: "logo", files: map[string][]byte{"logo.png": pngHeader}, want: http.StatusOK},
+		"missing file":       {title: "logo", want: http.StatusBadRequest, wantType: "required"},
+		"wrong extension":    {title: "logo", files: map[string][]byte{"logo.gif": pngHeader}, want: http.StatusBadRequest, wantType: "fileext"},
+		"wrong content":      {title: "logo", files: map[string][]byte{"logo.png": []byte("plain text")}, want: http.StatusBadRequest, wantType: "filetype"},
+		"file too large":     {title: "logo", files: map[string][]byte{"logo.png": append(pngHeader, make([]byte, 2048)...)}, want: http.StatusBadRequest, wantType: "maxfilesize"},
+		"missing form value": {files: map[string][]byte{"logo.png": pngHeader}, want: http.StatusBadRequest, wantType: "required"},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var got *testfixture.UploadRequest
+
+			sut := middleware.Handle(func(w http.ResponseWriter, _ *http.Request, body *testfixture.UploadRequest) {
+				got = body
+
+				w.WriteHeader(http.StatusOK)
+			}, middleware.WithErrorWriter(middleware.JSONErrorWriter))
+
+			rr := httptest.NewRecorder()
+			sut(rr, newMultipartRequest(t, tt.title, tt.files))
+
+			if rr.Code != tt.want {
+				t.Fatalf("Expected status %d, got %d: %s", tt.want, rr.Code, rr.Body.String())
+			}
+
+			if tt.want == http.StatusOK {
+				if got == nil || got.Title != tt.title || got.Image == nil || got.Image.Filename != "logo.png" {
+					t.Errorf("Expected the form to be bound, got %+v", got)
+				}
+
+				return
+			}
+
+			var resp middleware.ErrorResponse
+			if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+
+			if len(resp.Errors) != 1 || resp.Errors[0].Type != tt.wantType {
+				t.Errorf("Expected a single %s error, got %+v", tt.wantType, resp.Errors)
+			}
+		})
+	}
+}
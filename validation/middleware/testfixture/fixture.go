@@ -5,10 +5,41 @@
 // Package testfixture contains request fixtures used by middleware tests.
 package testfixture
 
+import "mime/multipart"
+
 // PersonRequest is the request payload used in middleware tests.
-// +govalid:required
+// +govalid:required;each
 type PersonRequest struct {
 	Name string `json:"name"`
 	// +govalid:email
 	Email string `json:"email"`
 }
+
+// SearchRequest is the query and path parameter fixture used in middleware tests.
+type SearchRequest struct {
+	// +govalid:required
+	Org string `path:"org"`
+	// +govalid:lte=100
+	Limit int      `query:"limit"`
+	Tags  []string `query:"tag"`
+}
+
+// SignupRequest is the fixture validated while decoding in middleware tests.
+// +govalid:unmarshal
+type SignupRequest struct {
+	// +govalid:email
+	Email string `json:"email"`
+}
+
+// UploadRequest is the multipart form fixture used in middleware tests.
+type UploadRequest struct {
+	// +govalid:required
+	Title string `form:"title"`
+	// +govalid:required
+	// +govalid:maxfilesize=1KB
+	// +govalid:filetype=image/png
+	// +govalid:fileext=.png
+	Image *multipart.FileHeader `form:"image"`
+	// +govalid:maxfiles=2
+	Attachments []*multipart.FileHeader `form:"attachment"`
+}
@@ -5,6 +5,7 @@ package testfixture
 
 import (
 	"errors"
+
 	"github.com/templatedop/govalid"
 	govaliderrors "github.com/templatedop/govalid/validation/errors"
 	"github.com/templatedop/govalid/validation/validationhelper"
@@ -14,6 +15,12 @@ var (
 	// ErrNilPersonRequest is returned when the PersonRequest is nil.
 	ErrNilPersonRequest = errors.New("input PersonRequest is nil")
 
+	// ErrPersonRequestNameRequiredValidation is returned when the Name is required but not provided.
+	ErrPersonRequestNameRequiredValidation = govaliderrors.ValidationError{Reason: "field Name is required", Path: "PersonRequest.Name", Type: "required"}
+
+	// ErrPersonRequestEmailRequiredValidation is returned when the Email is required but not provided.
+	ErrPersonRequestEmailRequiredValidation = govaliderrors.ValidationError{Reason: "field Email is required", Path: "PersonRequest.Email", Type: "required"}
+
 	// ErrPersonRequestEmailEmailValidation is the error returned when the field is not a valid email address.
 	ErrPersonRequestEmailEmailValidation = govaliderrors.ValidationError{Reason: "field Email must be a valid email address", Path: "PersonRequest.Email", Type: "email"}
 )
@@ -25,6 +32,18 @@ func ValidatePersonRequest(t *PersonRequest) error {
 
 	var errs govaliderrors.ValidationErrors
 
+	if t.Name == "" {
+		err := ErrPersonRequestNameRequiredValidation
+		err.Value = t.Name
+		errs = append(errs, err)
+	}
+
+	if t.Email == "" {
+		err := ErrPersonRequestEmailRequiredValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
 	if !validationhelper.IsValidEmail(t.Email) {
 		err := ErrPersonRequestEmailEmailValidation
 		err.Value = t.Email
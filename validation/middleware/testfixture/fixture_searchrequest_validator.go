@@ -0,0 +1,126 @@
+//go:build test
+
+// Code generated by govalid; DO NOT EDIT.
+package testfixture
+
+import (
+	"errors"
+	"net/url"
+	"strconv"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+var (
+	// ErrNilSearchRequest is returned when the SearchRequest is nil.
+	ErrNilSearchRequest = errors.New("input SearchRequest is nil")
+
+	// ErrSearchRequestOrgRequiredValidation is returned when the Org is required but not provided.
+	ErrSearchRequestOrgRequiredValidation = govaliderrors.ValidationError{Reason: "field Org is required", Path: "SearchRequest.Org", Type: "required"}
+
+	// ErrSearchRequestLimitLTEValidation is the error returned when the value of the field is greater than 100.
+	ErrSearchRequestLimitLTEValidation = govaliderrors.ValidationError{Reason: "field Limit must be less than or equal to 100", Path: "SearchRequest.Limit", Type: "lte", Param: "100"}
+
+	// ErrSearchRequestLimitConversionValidation is the error returned when the value of the field cannot be converted to int.
+	ErrSearchRequestLimitConversionValidation = govaliderrors.ValidationError{Reason: "field Limit must be a valid int", Path: "SearchRequest.Limit", Type: "conversion", Param: "int"}
+)
+
+func ValidateSearchRequest(t *SearchRequest) error {
+	if t == nil {
+		return ErrNilSearchRequest
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.Org == "" {
+		err := ErrSearchRequestOrgRequiredValidation
+		err.Value = t.Org
+		errs = append(errs, err)
+	}
+
+	if !(t.Limit <= 100) {
+		err := ErrSearchRequestLimitLTEValidation
+		err.Value = t.Limit
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*SearchRequest)(nil)
+
+func (t *SearchRequest) Validate() error {
+	return ValidateSearchRequest(t)
+}
+
+// bindSearchRequestValues assigns the fields of t from values, converting each value to its field type.
+func bindSearchRequestValues(t *SearchRequest, values url.Values) govaliderrors.ValidationErrors {
+	var errs govaliderrors.ValidationErrors
+
+	if raw, ok := values["org"]; ok && len(raw) > 0 {
+		s := raw[0]
+		t.Org = s
+	}
+
+	if raw, ok := values["limit"]; ok && len(raw) > 0 {
+		s := raw[0]
+		if s != "" {
+			v, err := strconv.ParseInt(s, 10, 0)
+			if err != nil {
+				verr := ErrSearchRequestLimitConversionValidation
+				verr.Value = s
+				errs = append(errs, verr)
+			} else {
+				t.Limit = int(v)
+			}
+		}
+	}
+
+	if raw, ok := values["tag"]; ok {
+		t.Tags = make([]string, 0, len(raw))
+		for _, s := range raw {
+			t.Tags = append(t.Tags, s)
+		}
+	}
+
+	return errs
+}
+
+// DecodeValues assigns the fields of t from values, reporting values that cannot be converted
+// to their field type as ValidationErrors. It does not run the validation rules.
+func (t *SearchRequest) DecodeValues(values url.Values) error {
+	if errs := bindSearchRequestValues(t, values); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// BindSearchRequestFromValues creates a SearchRequest from values and validates it.
+// Values that cannot be converted to their field type are reported as ValidationErrors
+// together with the violations of the validation rules.
+func BindSearchRequestFromValues(values url.Values) (*SearchRequest, error) {
+	t := &SearchRequest{}
+	errs := bindSearchRequestValues(t, values)
+
+	if err := ValidateSearchRequest(t); err != nil {
+		var verrs govaliderrors.ValidationErrors
+		if !errors.As(err, &verrs) {
+			return t, err
+		}
+		errs = append(errs, verrs...)
+	}
+
+	if len(errs) > 0 {
+		return t, errs
+	}
+	return t, nil
+}
+
+// PathParams returns the names of the path parameters bound into SearchRequest.
+func (t *SearchRequest) PathParams() []string {
+	return []string{"org"}
+}
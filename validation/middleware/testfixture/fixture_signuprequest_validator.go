@@ -0,0 +1,57 @@
+//go:build test
+
+// Code generated by govalid; DO NOT EDIT.
+package testfixture
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilSignupRequest is returned when the SignupRequest is nil.
+	ErrNilSignupRequest = errors.New("input SignupRequest is nil")
+
+	// ErrSignupRequestEmailEmailValidation is the error returned when the field is not a valid email address.
+	ErrSignupRequestEmailEmailValidation = govaliderrors.ValidationError{Reason: "field Email must be a valid email address", Path: "SignupRequest.Email", Type: "email"}
+)
+
+func ValidateSignupRequest(t *SignupRequest) error {
+	if t == nil {
+		return ErrNilSignupRequest
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if !validationhelper.IsValidEmail(t.Email) {
+		err := ErrSignupRequestEmailEmailValidation
+		err.Value = t.Email
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*SignupRequest)(nil)
+
+func (t *SignupRequest) Validate() error {
+	return ValidateSignupRequest(t)
+}
+
+// UnmarshalJSON decodes data into t and validates the result with ValidateSignupRequest,
+// so that json.Unmarshal returns the ValidationErrors.
+func (t *SignupRequest) UnmarshalJSON(data []byte) error {
+	type alias SignupRequest
+	if err := json.Unmarshal(data, (*alias)(t)); err != nil {
+		return err
+	}
+
+	return ValidateSignupRequest(t)
+}
@@ -0,0 +1,153 @@
+//go:build test
+
+// Code generated by govalid; DO NOT EDIT.
+package testfixture
+
+import (
+	"errors"
+	"mime/multipart"
+	"net/url"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+	"github.com/templatedop/govalid/validation/validationhelper"
+)
+
+var (
+	// ErrNilUploadRequest is returned when the UploadRequest is nil.
+	ErrNilUploadRequest = errors.New("input UploadRequest is nil")
+
+	// ErrUploadRequestTitleRequiredValidation is returned when the Title is required but not provided.
+	ErrUploadRequestTitleRequiredValidation = govaliderrors.ValidationError{Reason: "field Title is required", Path: "UploadRequest.Title", Type: "required"}
+
+	// ErrUploadRequestImageFileExtValidation is the error returned when the extension of an uploaded file is not one of .png.
+	ErrUploadRequestImageFileExtValidation = govaliderrors.ValidationError{Reason: "field Image must be a file with extension .png", Path: "UploadRequest.Image", Type: "fileext", Param: ".png"}
+
+	// ErrUploadRequestImageFileTypeValidation is the error returned when the detected content type of an uploaded file is not one of image/png.
+	ErrUploadRequestImageFileTypeValidation = govaliderrors.ValidationError{Reason: "field Image must be a file of type image/png", Path: "UploadRequest.Image", Type: "filetype", Param: "image/png"}
+
+	// ErrUploadRequestImageMaxFileSizeValidation is the error returned when an uploaded file is larger than 1KB.
+	ErrUploadRequestImageMaxFileSizeValidation = govaliderrors.ValidationError{Reason: "field Image must not exceed 1KB", Path: "UploadRequest.Image", Type: "maxfilesize", Param: "1KB"}
+
+	// ErrUploadRequestImageRequiredValidation is returned when the Image is required but not provided.
+	ErrUploadRequestImageRequiredValidation = govaliderrors.ValidationError{Reason: "field Image is required", Path: "UploadRequest.Image", Type: "required"}
+
+	// ErrUploadRequestAttachmentsMaxFilesValidation is the error returned when more than 2 files are uploaded.
+	ErrUploadRequestAttachmentsMaxFilesValidation = govaliderrors.ValidationError{Reason: "field Attachments must have a maximum of 2 files", Path: "UploadRequest.Attachments", Type: "maxfiles", Param: "2"}
+)
+
+func ValidateUploadRequest(t *UploadRequest) error {
+	if t == nil {
+		return ErrNilUploadRequest
+	}
+
+	var errs govaliderrors.ValidationErrors
+
+	if t.Title == "" {
+		err := ErrUploadRequestTitleRequiredValidation
+		err.Value = t.Title
+		errs = append(errs, err)
+	}
+
+	if !validationhelper.IsFileExt(t.Image, ".png") {
+		err := ErrUploadRequestImageFileExtValidation
+		err.Value = t.Image
+		errs = append(errs, err)
+	}
+
+	if !validationhelper.IsFileType(t.Image, "image/png") {
+		err := ErrUploadRequestImageFileTypeValidation
+		err.Value = t.Image
+		errs = append(errs, err)
+	}
+
+	if !validationhelper.IsFileSizeAtMost(t.Image, 1024) {
+		err := ErrUploadRequestImageMaxFileSizeValidation
+		err.Value = t.Image
+		errs = append(errs, err)
+	}
+
+	if t.Image == nil {
+		err := ErrUploadRequestImageRequiredValidation
+		err.Value = t.Image
+		errs = append(errs, err)
+	}
+
+	if len(t.Attachments) > 2 {
+		err := ErrUploadRequestAttachmentsMaxFilesValidation
+		err.Value = t.Attachments
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+var _ govalid.Validator = (*UploadRequest)(nil)
+
+func (t *UploadRequest) Validate() error {
+	return ValidateUploadRequest(t)
+}
+
+// bindUploadRequestValues assigns the fields of t from values, converting each value to its field type.
+func bindUploadRequestValues(t *UploadRequest, values url.Values) govaliderrors.ValidationErrors {
+	var errs govaliderrors.ValidationErrors
+
+	if raw, ok := values["title"]; ok && len(raw) > 0 {
+		s := raw[0]
+		t.Title = s
+	}
+
+	return errs
+}
+
+// DecodeValues assigns the fields of t from values, reporting values that cannot be converted
+// to their field type as ValidationErrors. It does not run the validation rules.
+func (t *UploadRequest) DecodeValues(values url.Values) error {
+	if errs := bindUploadRequestValues(t, values); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// DecodeMultipart assigns the fields of t from a parsed multipart form, binding the uploaded files
+// to the file fields and the values to the others. It does not run the validation rules.
+func (t *UploadRequest) DecodeMultipart(form *multipart.Form) error {
+	errs := bindUploadRequestValues(t, url.Values(form.Value))
+
+	if files := form.File["image"]; len(files) > 0 {
+		t.Image = files[0]
+	}
+
+	if files, ok := form.File["attachment"]; ok {
+		t.Attachments = files
+	}
+
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// BindUploadRequestFromValues creates a UploadRequest from values and validates it.
+// Values that cannot be converted to their field type are reported as ValidationErrors
+// together with the violations of the validation rules.
+func BindUploadRequestFromValues(values url.Values) (*UploadRequest, error) {
+	t := &UploadRequest{}
+	errs := bindUploadRequestValues(t, values)
+
+	if err := ValidateUploadRequest(t); err != nil {
+		var verrs govaliderrors.ValidationErrors
+		if !errors.As(err, &verrs) {
+			return t, err
+		}
+		errs = append(errs, verrs...)
+	}
+
+	if len(errs) > 0 {
+		return t, errs
+	}
+	return t, nil
+}
@@ -0,0 +1,106 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+
+	"github.com/templatedop/govalid"
+	govaliderrors "github.com/templatedop/govalid/validation/errors"
+)
+
+// ValuesValidator is a Validator that can also be bound from url.Values, as the types
+// with query, form or path tags for which govalid generates a DecodeValues method.
+type ValuesValidator interface {
+	govalid.Validator
+	ValuesDecoder
+}
+
+// pathParamer is implemented by the generated binders of types with path tags.
+type pathParamer interface {
+	PathParams() []string
+}
+
+// HandleValues returns a handler that binds T from the request parameters and passes
+// the validated value to next. Query parameters and url-encoded form fields are read
+// with r.ParseForm, and path parameters declared with path tags are read with r.PathValue.
+// Values that cannot be converted to their field type are reported together with the
+// violations of the validation rules, with the status set with WithStatus.
+// The value is also stored in the request context, see FromContext.
+func HandleValues[T ValuesValidator](next func(http.ResponseWriter, *http.Request, T), opts ...Option) http.HandlerFunc {
+	o := newOptions(opts)
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		body, target := newBody[T]()
+
+		decoder, ok := target.(ValuesDecoder)
+		if !ok {
+			o.errorWriter(w, r, http.StatusInternalServerError, fmt.Errorf("%T does not implement ValuesDecoder", target))
+
+			return
+		}
+
+		if o.maxBodyBytes > 0 && r.Body != nil {
+			r.Body = http.MaxBytesReader(w, r.Body, o.maxBodyBytes)
+		}
+
+		if err := r.ParseForm(); err != nil {
+			err = bodyError(err)
+			o.errorWriter(w, r, decodeStatus(err), err)
+
+			return
+		}
+
+		// Path parameters are merged into a copy, so that r.Form is left as parsed.
+		values := maps.Clone(r.Form)
+		if p, ok := target.(pathParamer); ok {
+			for _, name := range p.PathParams() {
+				if value := r.PathValue(name); value != "" {
+					values[name] = []string{value}
+				}
+			}
+		}
+
+		var errs govaliderrors.ValidationErrors
+
+		if err := decoder.DecodeValues(values); err != nil {
+			if !errors.As(err, &errs) {
+				err = bodyError(err)
+				o.errorWriter(w, r, decodeStatus(err), err)
+
+				return
+			}
+		}
+
+		if err := body.Validate(); err != nil {
+			var verrs govaliderrors.ValidationErrors
+			if !errors.As(err, &verrs) {
+				o.errorWriter(w, r, o.status, err)
+
+				return
+			}
+
+			errs = append(errs, verrs...)
+		}
+
+		if len(errs) > 0 {
+			o.errorWriter(w, r, o.status, errs)
+
+			return
+		}
+
+		r = r.WithContext(context.WithValue(r.Context(), contextKey{}, body))
+
+		next(w, r, body)
+	}
+}
+
+// ValidateValues returns a middleware that binds and validates the request parameters
+// as T, like HandleValues, for handlers that retrieve the value with FromContext.
+func ValidateValues[T ValuesValidator](next http.HandlerFunc, opts ...Option) http.HandlerFunc {
+	return HandleValues(func(w http.ResponseWriter, r *http.Request, _ T) {
+		next(w, r)
+	}, opts...)
+}
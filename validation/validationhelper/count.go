@@ -0,0 +1,14 @@
+package validationhelper
+
+// CountTrue returns the number of true values, e.g., the number of set fields of a field group.
+func CountTrue(values ...bool) int {
+	n := 0
+
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,103 @@
+package validationhelper
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"path/filepath"
+	"strings"
+)
+
+// sniffLen is the number of bytes http.DetectContentType considers.
+const sniffLen = 512
+
+// IsFileSizeAtMost reports whether the file is at most maxSize bytes. A nil file is valid.
+func IsFileSizeAtMost(file *multipart.FileHeader, maxSize int64) bool {
+	return file == nil || file.Size <= maxSize
+}
+
+// IsFileType reports whether the content type of the file, detected from its first bytes with
+// http.DetectContentType, is one of the allowed media types. An allowed type ending in "/*",
+// such as "image/*", matches every subtype. The Content-Type header sent by the client is ignored,
+// as it cannot be trusted. A nil file is valid; a file that cannot be read is not.
+func IsFileType(file *multipart.FileHeader, allowed ...string) bool {
+	if file == nil {
+		return true
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		return false
+	}
+	defer func() { _ = f.Close() }()
+
+	buf := make([]byte, sniffLen)
+
+	n, err := io.ReadFull(f, buf)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return false
+	}
+
+	detected, _, err := mime.ParseMediaType(http.DetectContentType(buf[:n]))
+	if err != nil {
+		return false
+	}
+
+	for _, a := range allowed {
+		if prefix, ok := strings.CutSuffix(a, "/*"); ok {
+			if strings.HasPrefix(detected, prefix+"/") {
+				return true
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(detected, a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFileExt reports whether the extension of the file name, e.g., ".png", is one of the allowed
+// extensions, ignoring case. A nil file is valid.
+func IsFileExt(file *multipart.FileHeader, allowed ...string) bool {
+	if file == nil {
+		return true
+	}
+
+	ext := filepath.Ext(file.Filename)
+	for _, a := range allowed {
+		if strings.EqualFold(ext, a) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AllFiles reports whether every file satisfies the check called with args,
+// e.g., AllFiles(files, IsFileExt, ".png", ".jpg").
+func AllFiles(files []*multipart.FileHeader, check func(*multipart.FileHeader, ...string) bool, args ...string) bool {
+	for _, file := range files {
+		if !check(file, args...) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// AreFileSizesAtMost reports whether every file is at most maxSize bytes.
+func AreFileSizesAtMost(files []*multipart.FileHeader, maxSize int64) bool {
+	for _, file := range files {
+		if !IsFileSizeAtMost(file, maxSize) {
+			return false
+		}
+	}
+
+	return true
+}
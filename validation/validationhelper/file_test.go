@@ -0,0 +1,93 @@
+package validationhelper
+
+import (
+	"bytes"
+	"mime/multipart"
+	"testing"
+)
+
+func newFileHeader(t *testing.T, name string, content []byte) *multipart.FileHeader {
+	t.Helper()
+
+	var body bytes.Buffer
+
+	mw := multipart.NewWriter(&body)
+
+	fw, err := mw.CreateFormFile("file", name)
+	if err != nil {
+		t.Fatalf("failed to create form file: %v", err)
+	}
+
+	if _, err := fw.Write(content); err != nil {
+		t.Fatalf("failed to write form file: %v", err)
+	}
+
+	if err := mw.Close(); err != nil {
+		t.Fatalf("failed to close writer: %v", err)
+	}
+
+	form, err := multipart.NewReader(&body, mw.Boundary()).ReadForm(1 << 20)
+	if err != nil {
+		t.Fatalf("failed to read form: %v", err)
+	}
+
+	t.Cleanup(func() { _ = form.RemoveAll() })
+
+	return form.File["file"][0]
+}
+
+func TestIsFileType(t *testing.T) {
+	png := newFileHeader(t, "image.png", []byte("\x89PNG\r\n\x1a\n"))
+	text := newFileHeader(t, "notes.png", []byte("plain text"))
+
+	tests := []struct {
+		name     string
+		file     *multipart.FileHeader
+		allowed  []string
+		expected bool
+	}{
+		{"exact_type", png, []string{"image/png"}, true},
+		{"wildcard_type", png, []string{"image/*"}, true},
+		{"other_type", png, []string{"application/pdf"}, false},
+		{"detected_not_extension", text, []string{"image/png"}, false},
+		{"parameters_ignored", text, []string{"text/plain"}, true},
+		{"nil_file", nil, []string{"image/png"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsFileType(tt.file, tt.allowed...); got != tt.expected {
+				t.Errorf("IsFileType() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIsFileExt(t *testing.T) {
+	file := newFileHeader(t, "Photo.JPG", []byte("data"))
+
+	if !IsFileExt(file, ".png", ".jpg") {
+		t.Error("expected the extension to match ignoring case")
+	}
+
+	if IsFileExt(file, ".png") {
+		t.Error("expected the extension not to match")
+	}
+
+	if !AllFiles([]*multipart.FileHeader{file, nil}, IsFileExt, ".jpg") {
+		t.Error("expected every file to match")
+	}
+}
+
+func TestAreFileSizesAtMost(t *testing.T) {
+	small := newFileHeader(t, "small.txt", make([]byte, 10))
+	large := newFileHeader(t, "large.txt", make([]byte, 100))
+
+	if !AreFileSizesAtMost([]*multipart.FileHeader{small}, 10) {
+		t.Error("expected a file of exactly the maximum size to be valid")
+	}
+
+	if AreFileSizesAtMost([]*multipart.FileHeader{small, large}, 50) {
+		t.Error("expected a larger file to be invalid")
+	}
+}
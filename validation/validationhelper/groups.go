@@ -0,0 +1,14 @@
+package validationhelper
+
+import "slices"
+
+// InGroups reports whether one of the groups of a rule is among the requested validation groups.
+func InGroups(requested []string, groups ...string) bool {
+	for _, group := range groups {
+		if slices.Contains(requested, group) {
+			return true
+		}
+	}
+
+	return false
+}